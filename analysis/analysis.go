@@ -0,0 +1,193 @@
+// Package analysis describes the accuracy of a posit configuration so formats can be
+// compared: the dynamic range, the decimal digits of accuracy in each binade, the golden
+// zone where a posit is more accurate than a float of the same size, and the distribution
+// of rounding errors of arithmetic on sampled inputs. Results can be written as CSV for
+// plotting.
+//
+// Decimal accuracy follows Gustafson: if x is rounded to x' then the error in decimal
+// digits is |log10(x'/x)| and the accuracy is -log10 of that. The worst case in a binade
+// is a value halfway, geometrically, between the two smallest posits of the binade.
+package analysis
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/cjdelisle/goposit"
+)
+
+// Range is the dynamic range of a format
+type Range struct {
+	// MinPos and MaxPos are the smallest and largest positive values
+	MinPos *big.Float
+	MaxPos *big.Float
+
+	// MinScale and MaxScale are the base 2 logarithms of MinPos and MaxPos
+	MinScale int
+	MaxScale int
+
+	// Decades is log10(MaxPos / MinPos)
+	Decades float64
+}
+
+func newRange(minScale, maxScale int, minPos, maxPos *big.Float) Range {
+	return Range{
+		MinPos:   minPos,
+		MaxPos:   maxPos,
+		MinScale: minScale,
+		MaxScale: maxScale,
+		Decades:  float64(maxScale-minScale) * math.Log10(2),
+	}
+}
+
+// DynamicRange returns the range of a posit configuration
+func DynamicRange(nbits, es uint) Range {
+	p := goposit.NewSlowPosit(nbits, es)
+	max := p.Log2MaxVal()
+	return newRange(-max, max, p.Clone().Min().ToFloat(), p.Clone().Max().ToFloat())
+}
+
+// Accuracy describes the values in one binade [2**Scale, 2**(Scale+1))
+type Accuracy struct {
+	Scale int
+
+	// FractionBits is the number of fraction bits of values in the binade, for a posit
+	// with no fraction bits here the gap to the next value may be more than one binade.
+	FractionBits int
+
+	// Worst is the decimal accuracy at the start of the binade, where the relative gap
+	// between values is widest, and Best is at the end of the binade.
+	Worst float64
+	Best  float64
+}
+
+// digits is the decimal accuracy halfway between a and the next larger value, where gap
+// is (next - a) / a
+func digits(gap float64) float64 {
+	return -math.Log10(math.Log1p(gap) / math.Ln10 / 2)
+}
+
+// gap returns (b - a) / a
+func gap(a, b *big.Float) float64 {
+	d := new(big.Float).Sub(b, a)
+	g, _ := d.Quo(d, a).Float64()
+	return g
+}
+
+// DecimalAccuracy returns the accuracy of each binade which contains a positive posit,
+// from the smallest to the largest. The binade of maxpos has no next value so its
+// accuracy is measured on the gap below maxpos.
+func DecimalAccuracy(nbits, es uint) []Accuracy {
+	r := DynamicRange(nbits, es)
+	var out []Accuracy
+	for s := r.MinScale; s <= r.MaxScale; s++ {
+		first := goposit.NewSlowPosit(nbits, es)
+		first.FromFloat(new(big.Float).SetMantExp(big.NewFloat(1), s), true)
+		if first.Bits.Sign() == 0 || first.ToFloat().MantExp(nil) != s+1 {
+			// no posit has this scale, the regime skips over it
+			continue
+		}
+		fields := first.Decode()
+		next := first.NextUp()
+		// the last posit of the binade is the one before the next power of two
+		last := goposit.NewSlowPosit(nbits, es)
+		last.FromFloat(new(big.Float).SetMantExp(big.NewFloat(1), s+1), true)
+		if last.ToFloat().MantExp(nil) != s+1 {
+			last = last.NextDown()
+		}
+		after := last.NextUp()
+		if next.IsNaR() {
+			first, next = first.NextDown(), first
+		}
+		if after.IsNaR() {
+			last, after = last.NextDown(), last
+		}
+		out = append(out, Accuracy{
+			Scale:        s,
+			FractionBits: fields.FractionBits,
+			Worst:        digits(gap(first.ToFloat(), next.ToFloat())),
+			Best:         digits(gap(last.ToFloat(), after.ToFloat())),
+		})
+	}
+	return out
+}
+
+// FloatFormat is an IEEE 754 style binary format with subnormals
+type FloatFormat struct {
+	Name     string
+	ExpBits  int
+	FracBits int
+}
+
+var (
+	Float16  = FloatFormat{"float16", 5, 10}
+	BFloat16 = FloatFormat{"bfloat16", 8, 7}
+	Float32  = FloatFormat{"float32", 8, 23}
+	Float64  = FloatFormat{"float64", 11, 52}
+)
+
+// FloatFormats lists the predefined formats
+var FloatFormats = []FloatFormat{Float16, BFloat16, Float32, Float64}
+
+func (f FloatFormat) bias() int { return 1<<(f.ExpBits-1) - 1 }
+
+// DynamicRange returns the range of the format, MinPos is the smallest subnormal
+func (f FloatFormat) DynamicRange() Range {
+	minScale := 1 - f.bias() - f.FracBits
+	maxScale := f.bias()
+	max := new(big.Float).SetMantExp(big.NewFloat(2-math.Ldexp(1, -f.FracBits)), maxScale)
+	return newRange(minScale, maxScale, new(big.Float).SetMantExp(big.NewFloat(1), minScale), max)
+}
+
+// DecimalAccuracy returns the accuracy of each binade of the format, subnormal binades
+// have fewer fraction bits.
+func (f FloatFormat) DecimalAccuracy() []Accuracy {
+	var out []Accuracy
+	r := f.DynamicRange()
+	for s := r.MinScale; s <= r.MaxScale; s++ {
+		bits := f.FracBits
+		if s < 1-f.bias() {
+			bits -= 1 - f.bias() - s
+		}
+		ulp := math.Ldexp(1, -bits)
+		out = append(out, Accuracy{
+			Scale:        s,
+			FractionBits: bits,
+			Worst:        digits(ulp),
+			Best:         digits(ulp / (2 - ulp)),
+		})
+	}
+	return out
+}
+
+// GoldenZone returns the range of binades around 1 in which the posit has at least as
+// many fraction bits as the float, ok is false if it has fewer even at 1.
+func GoldenZone(nbits, es uint, f FloatFormat) (minScale, maxScale int, ok bool) {
+	posit := map[int]int{}
+	for _, a := range DecimalAccuracy(nbits, es) {
+		posit[a.Scale] = a.FractionBits
+	}
+	float := map[int]int{}
+	for _, a := range f.DecimalAccuracy() {
+		float[a.Scale] = a.FractionBits
+	}
+	better := func(s int) bool {
+		pb, ok := posit[s]
+		fb, ok2 := float[s]
+		return ok && (!ok2 || pb >= fb)
+	}
+	if !better(0) {
+		return 0, 0, false
+	}
+	for minScale = 0; better(minScale - 1); minScale-- {
+	}
+	for maxScale = 0; better(maxScale + 1); maxScale++ {
+	}
+	return minScale, maxScale, true
+}
+
+// String describes the range in one line
+func (r Range) String() string {
+	return fmt.Sprintf("minpos 2^%d maxpos 2^%d, %.1f decades", r.MinScale, r.MaxScale, r.Decades)
+}
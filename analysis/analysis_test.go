@@ -0,0 +1,133 @@
+package analysis_test
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/cjdelisle/goposit/analysis"
+)
+
+func TestDynamicRange(t *testing.T) {
+	r := analysis.DynamicRange(16, 1)
+	if r.MinScale != -28 || r.MaxScale != 28 || math.Abs(r.Decades-56*math.Log10(2)) > 1e-9 {
+		t.Errorf("unexpected range %v", r)
+	}
+	if f, _ := r.MaxPos.Float64(); f != 1<<28 {
+		t.Errorf("maxpos should be 2^28 got %v", f)
+	}
+	f := analysis.Float16.DynamicRange()
+	if max, _ := f.MaxPos.Float64(); f.MinScale != -24 || f.MaxScale != 15 || max != 65504 {
+		t.Errorf("unexpected float16 range %v maxpos %v", f, max)
+	}
+}
+
+func TestDecimalAccuracy(t *testing.T) {
+	acc := analysis.DecimalAccuracy(8, 0)
+	if len(acc) != 13 || acc[0].Scale != -6 || acc[12].Scale != 6 {
+		t.Fatalf("expected the 13 binades of Posit8, got %+v", acc)
+	}
+	for i, a := range acc {
+		// binade s mirrors binade -1-s, apart from the binades of minpos and maxpos
+		if i > 0 && a.Scale < 0 {
+			if b := acc[len(acc)-2-i]; a.FractionBits != b.FractionBits {
+				t.Errorf("binade %d %+v does not match %+v", a.Scale, a, b)
+			}
+		}
+		if a.Best < a.Worst {
+			t.Errorf("binade %d best is below worst", a.Scale)
+		}
+	}
+	// 1 is followed by 1 + 2**-5, halfway the error is log10(1 + 2**-5) / 2
+	if one := acc[6]; one.FractionBits != 5 || math.Abs(one.Worst+math.Log10(math.Log10(1+1.0/32)/2)) > 1e-12 {
+		t.Errorf("unexpected accuracy at 1: %+v", one)
+	}
+
+	// regimes skip binades once the fraction runs out, es 2 maxpos is 2**24
+	acc = analysis.DecimalAccuracy(8, 2)
+	if last := acc[len(acc)-1]; last.Scale != 24 || acc[len(acc)-2].Scale != 20 {
+		t.Errorf("unexpected top binades %+v", acc[len(acc)-3:])
+	}
+
+	f := analysis.Float32.DecimalAccuracy()
+	for _, a := range f {
+		if a.Scale == 0 && (a.FractionBits != 23 || a.Worst < 7.5 || a.Worst > 7.7) {
+			t.Errorf("float32 should have about 7.6 digits at 1, got %+v", a)
+		}
+		if a.Scale == -149 && a.FractionBits != 0 {
+			t.Errorf("the smallest float32 subnormal has no fraction, got %+v", a)
+		}
+	}
+}
+
+func TestGoldenZone(t *testing.T) {
+	for _, c := range []struct {
+		nbits, es uint
+		f         analysis.FloatFormat
+		lo, hi    int
+		ok        bool
+	}{
+		{16, 1, analysis.Float16, -6, 5, true},
+		{32, 2, analysis.Float32, -20, 19, true},
+		{16, 3, analysis.Float16, -8, 7, true},
+		{16, 4, analysis.Float16, 0, 0, false},
+	} {
+		lo, hi, ok := analysis.GoldenZone(c.nbits, c.es, c.f)
+		if lo != c.lo || hi != c.hi || ok != c.ok {
+			t.Errorf("%d bit es %d vs %v: got %d %d %v", c.nbits, c.es, c.f.Name, lo, hi, ok)
+		}
+	}
+}
+
+func TestOpErrors(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	r := analysis.OpErrors(16, 1, analysis.Mul, 1000, -4, 4, rng)
+	if len(r.Samples) != 1000 {
+		t.Fatalf("expected 1000 samples got %d", len(r.Samples))
+	}
+	// products are within 2**-8 to 2**10 where Posit16 has at least 8 fraction bits
+	if r.Max > math.Ldexp(1, -9) || r.Max == 0 || r.Mean > r.RMS || r.Median > r.Max {
+		t.Errorf("unexpected statistics %+v", *r)
+	}
+	total := r.Exact
+	for _, n := range r.Histogram() {
+		total += n
+	}
+	if total != 1000 {
+		t.Errorf("the histogram and exact results should count every sample, got %d", total)
+	}
+
+	r2 := analysis.OpErrors(16, 1, analysis.Mul, 1000, -4, 4, rand.New(rand.NewSource(1)))
+	if r2.Mean != r.Mean {
+		t.Error("the same seed should give the same samples")
+	}
+	for _, s := range analysis.OpErrors(8, 0, analysis.Add, 200, -20, 20, rng).Samples {
+		if math.IsNaN(s.Result) {
+			t.Errorf("adding reals should not give NaR: %+v", s)
+		}
+	}
+}
+
+func TestCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := analysis.WriteAccuracyCSV(&buf, []string{"p8", "f16"}, analysis.DecimalAccuracy(8, 0),
+		analysis.Float16.DecimalAccuracy())
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if lines[0] != "scale,magnitude,p8_fraction_bits,p8_worst_digits,p8_best_digits,f16_fraction_bits,f16_worst_digits,f16_best_digits" {
+		t.Errorf("unexpected header %v", lines[0])
+	}
+	// float16 goes from 2**-24 to 2**15 and every binade has a row
+	if len(lines) != 1+40 || !strings.HasPrefix(lines[1], "-24,2^-24,,,,0,") {
+		t.Errorf("unexpected rows %v ... %v", lines[1], len(lines))
+	}
+	buf.Reset()
+	analysis.WriteHistogramCSV(&buf, analysis.OpErrors(8, 0, analysis.Div, 100, 0, 0, rand.New(rand.NewSource(2))))
+	if !strings.HasPrefix(buf.String(), "digits,count\nexact,") {
+		t.Errorf("unexpected histogram %v", buf.String())
+	}
+}
@@ -0,0 +1,90 @@
+package analysis
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+func ftoa(f float64) string { return strconv.FormatFloat(f, 'g', 6, 64) }
+
+// WriteRangeCSV writes the dynamic range as name,value rows
+func WriteRangeCSV(w io.Writer, r Range) error {
+	cw := csv.NewWriter(w)
+	cw.WriteAll([][]string{
+		{"name", "value"},
+		{"minpos", r.MinPos.Text('g', 10)},
+		{"maxpos", r.MaxPos.Text('g', 10)},
+		{"min_scale", strconv.Itoa(r.MinScale)},
+		{"max_scale", strconv.Itoa(r.MaxScale)},
+		{"decades", ftoa(r.Decades)},
+	})
+	return cw.Error()
+}
+
+// WriteAccuracyCSV writes one row per binade with the accuracy of each format, named in
+// the header by names, so formats can be plotted together. A format with no values in
+// a binade has empty cells.
+func WriteAccuracyCSV(w io.Writer, names []string, formats ...[]Accuracy) error {
+	cw := csv.NewWriter(w)
+	header := []string{"scale", "magnitude"}
+	for _, n := range names {
+		header = append(header, n+"_fraction_bits", n+"_worst_digits", n+"_best_digits")
+	}
+	cw.Write(header)
+
+	min, max := 0, 0
+	byScale := make([]map[int]Accuracy, len(formats))
+	for i, f := range formats {
+		byScale[i] = map[int]Accuracy{}
+		for _, a := range f {
+			byScale[i][a.Scale] = a
+			if a.Scale < min {
+				min = a.Scale
+			}
+			if a.Scale > max {
+				max = a.Scale
+			}
+		}
+	}
+	for s := min; s <= max; s++ {
+		row := []string{strconv.Itoa(s), "2^" + strconv.Itoa(s)}
+		found := false
+		for _, m := range byScale {
+			if a, ok := m[s]; ok {
+				found = true
+				row = append(row, strconv.Itoa(a.FractionBits), ftoa(a.Worst), ftoa(a.Best))
+			} else {
+				row = append(row, "", "", "")
+			}
+		}
+		if found {
+			cw.Write(row)
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteSamplesCSV writes every sample of the report
+func WriteSamplesCSV(w io.Writer, r *ErrorReport) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"x", "y", "exact", "result", "rel_err", "digits"})
+	for _, s := range r.Samples {
+		cw.Write([]string{ftoa(s.X), ftoa(s.Y), ftoa(s.Exact), ftoa(s.Result), ftoa(s.RelErr), ftoa(s.Digits())})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteHistogramCSV writes the histogram of the report with the exact results first
+func WriteHistogramCSV(w io.Writer, r *ErrorReport) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"digits", "count"})
+	cw.Write([]string{"exact", strconv.Itoa(r.Exact)})
+	for d, n := range r.Histogram() {
+		cw.Write([]string{strconv.Itoa(d), strconv.Itoa(n)})
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,160 @@
+package analysis
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"sort"
+
+	"github.com/cjdelisle/goposit"
+)
+
+// Op is an arithmetic operation whose rounding error can be sampled
+type Op string
+
+const (
+	Add Op = "add"
+	Sub Op = "sub"
+	Mul Op = "mul"
+	Div Op = "div"
+)
+
+func (op Op) posit(x, y *goposit.SlowPosit) *goposit.SlowPosit {
+	switch op {
+	case Add:
+		return x.Add(y)
+	case Sub:
+		return x.Sub(y)
+	case Mul:
+		return x.Mul(y)
+	case Div:
+		return x.Div(y)
+	}
+	panic(fmt.Sprintf("unknown operation %q", op))
+}
+
+func (op Op) exact(x, y *big.Rat) *big.Rat {
+	switch op {
+	case Add:
+		return new(big.Rat).Add(x, y)
+	case Sub:
+		return new(big.Rat).Sub(x, y)
+	case Mul:
+		return new(big.Rat).Mul(x, y)
+	}
+	return new(big.Rat).Quo(x, y)
+}
+
+// Sample is one sampled operation, the relative error is |Result - Exact| / |Exact|
+type Sample struct {
+	X, Y   float64
+	Exact  float64
+	Result float64
+	RelErr float64
+}
+
+// Digits is the decimal accuracy of the result, +Inf if it is exact
+func (s Sample) Digits() float64 {
+	return -math.Log10(s.RelErr)
+}
+
+// ErrorReport is the distribution of rounding errors of an operation
+type ErrorReport struct {
+	Op      Op
+	Samples []Sample
+
+	// Exact is the number of samples whose result needed no rounding
+	Exact int
+
+	// statistics of the relative error
+	Mean   float64
+	RMS    float64
+	Median float64
+	Max    float64
+}
+
+// OpErrors rounds n pairs of random operands to the posit size, applies the operation and
+// compares the rounded result to the exact one. Operands have a random sign and are log
+// uniformly distributed between 2**minScale and 2**maxScale.
+func OpErrors(nbits, es uint, op Op, n int, minScale, maxScale int, rng *rand.Rand) *ErrorReport {
+	if maxScale < minScale {
+		panic("maxScale must not be less than minScale")
+	}
+	out := &ErrorReport{Op: op, Samples: make([]Sample, 0, n)}
+	random := func() *goposit.SlowPosit {
+		e := float64(minScale) + rng.Float64()*float64(maxScale-minScale+1)
+		f := math.Exp2(e)
+		if rng.Intn(2) == 0 {
+			f = -f
+		}
+		p := goposit.NewSlowPosit(nbits, es)
+		p.FromFloat(big.NewFloat(f), false)
+		return p
+	}
+	var errs []float64
+	for len(out.Samples) < n {
+		x, y := random(), random()
+		z := op.posit(x, y)
+		xr, _ := x.ToFloat().Rat(nil)
+		yr, _ := y.ToFloat().Rat(nil)
+		if op == Div && yr.Sign() == 0 {
+			continue
+		}
+		exact := op.exact(xr, yr)
+		s := Sample{}
+		s.X, _ = xr.Float64()
+		s.Y, _ = yr.Float64()
+		s.Exact, _ = exact.Float64()
+		if z.IsNaR() {
+			s.Result, s.RelErr = math.NaN(), math.Inf(1)
+		} else {
+			zr, _ := z.ToFloat().Rat(nil)
+			s.Result, _ = zr.Float64()
+			if exact.Sign() != 0 {
+				d := new(big.Rat).Sub(zr, exact)
+				s.RelErr, _ = d.Abs(d.Quo(d, exact)).Float64()
+			} else if zr.Sign() != 0 {
+				s.RelErr = math.Inf(1)
+			}
+		}
+		if s.RelErr == 0 {
+			out.Exact++
+		}
+		out.Samples = append(out.Samples, s)
+		errs = append(errs, s.RelErr)
+	}
+	if n == 0 {
+		return out
+	}
+	sort.Float64s(errs)
+	for _, e := range errs {
+		out.Mean += e / float64(n)
+		out.RMS += e * e / float64(n)
+	}
+	out.RMS = math.Sqrt(out.RMS)
+	out.Median = errs[n/2]
+	out.Max = errs[n-1]
+	return out
+}
+
+// Histogram counts the samples by whole decimal digits of accuracy, bucket i is the
+// number of inexact samples with between i and i+1 digits, less than 0 digits counts
+// as 0. Exact samples are not included, see Exact.
+func (r *ErrorReport) Histogram() []int {
+	var out []int
+	for _, s := range r.Samples {
+		if s.RelErr == 0 {
+			continue
+		}
+		d := int(math.Max(0, math.Floor(s.Digits())))
+		if math.IsInf(s.RelErr, 1) {
+			d = 0
+		}
+		for len(out) <= d {
+			out = append(out, 0)
+		}
+		out[d]++
+	}
+	return out
+}
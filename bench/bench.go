@@ -0,0 +1,261 @@
+// Package bench measures every posit operation on every size, including the vector types
+// and SlowPosits of any width, next to float32 and float64 so the cost of a precision can
+// be compared. The benchmarks can be run with go test -bench in this directory, or with
+// the positbench command which prints them as a comparison table.
+package bench
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"math/rand"
+	"regexp"
+	"strings"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/cjdelisle/goposit"
+)
+
+// Case is one benchmark, Type is the number type such as Posit16, float64 or Slow12es1
+// and Op is the operation such as Add.
+type Case struct {
+	Type string
+	Op   string
+
+	// Lanes is the number of values computed by each call, 4 for Posit8x4
+	Lanes int
+
+	F func(b *testing.B)
+}
+
+// Name returns Type/Op which is also the name used by go test -bench
+func (c Case) Name() string { return c.Type + "/" + c.Op }
+
+// Result is the measurement of a Case
+type Result struct {
+	Case
+	NsPerOp     float64
+	AllocsPerOp int64
+	BytesPerOp  int64
+}
+
+// NsPerValue is the time for one value, so a vector operation is divided by its lanes
+func (r Result) NsPerValue() float64 { return r.NsPerOp / float64(r.Lanes) }
+
+// Ops lists every operation in the order they are shown, not every type has all of them.
+// AddExact of a float is the TwoSum algorithm and MulPromote multiplies into the next
+// bigger type.
+var Ops = []string{"Add", "Sub", "Mul", "Div", "Sqrt", "AddExact", "MulPromote", "FromInt", "Int"}
+
+// SlowSizes are the SlowPosit configurations included by Cases
+var SlowSizes = [][2]uint{{12, 1}, {24, 2}, {48, 3}, {128, 4}}
+
+const nOperands = 64
+
+// operands returns positive values between 1/128 and 64 with random fractions, the same
+// values every time.
+func operands() []float64 {
+	rng := rand.New(rand.NewSource(1))
+	out := make([]float64, nOperands)
+	for i := range out {
+		out[i] = math.Ldexp(1+rng.Float64(), rng.Intn(13)-7)
+	}
+	return out
+}
+
+// operandBits returns the operands rounded to posits of the given size
+func operandBits(nbits, es uint) []*goposit.SlowPosit {
+	out := make([]*goposit.SlowPosit, nOperands)
+	for i, f := range operands() {
+		out[i] = goposit.NewSlowPosit(nbits, es)
+		out[i].FromFloat(big.NewFloat(f), false)
+	}
+	return out
+}
+
+// Cases returns the benchmarks for every type: float32, float64, Posit8 through Posit64,
+// the vector types and SlowPosit at each of SlowSizes.
+func Cases() []Case {
+	out := append(casesFloat32(), casesFloat64()...)
+	out = append(out, casesPosit8()...)
+	out = append(out, casesPosit16()...)
+	out = append(out, casesPosit32()...)
+	out = append(out, casesPosit64()...)
+	out = append(out, casesPosit8x4()...)
+	out = append(out, casesPosit16x2()...)
+	for _, s := range SlowSizes {
+		out = append(out, SlowCases(s[0], s[1])...)
+	}
+	return out
+}
+
+var slowSink *goposit.SlowPosit
+var slowIntSink int64
+
+// SlowCases returns the benchmarks for a SlowPosit of any size, the type is named for
+// example Slow12es1.
+func SlowCases(nbits, es uint) []Case {
+	x := operandBits(nbits, es)
+	name := fmt.Sprintf("Slow%des%d", nbits, es)
+	binary := func(op string, f func(a, b *goposit.SlowPosit) *goposit.SlowPosit) Case {
+		return Case{Type: name, Op: op, Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				slowSink = f(x[i%nOperands], x[(i+1)%nOperands])
+			}
+		}}
+	}
+	return []Case{
+		binary("Add", (*goposit.SlowPosit).Add),
+		binary("Sub", (*goposit.SlowPosit).Sub),
+		binary("Mul", (*goposit.SlowPosit).Mul),
+		binary("Div", (*goposit.SlowPosit).Div),
+		binary("Sqrt", func(a, _ *goposit.SlowPosit) *goposit.SlowPosit { return a.Sqrt() }),
+		binary("AddExact", func(a, b *goposit.SlowPosit) *goposit.SlowPosit { z, _ := a.AddExact(b); return z }),
+		binary("MulPromote", (*goposit.SlowPosit).MulPromote),
+		{Type: name, Op: "FromInt", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				slowSink = x[0].FromInt(int64(i & 0xff))
+			}
+		}},
+		{Type: name, Op: "Int", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				slowIntSink = x[i%nOperands].Int()
+			}
+		}},
+	}
+}
+
+// Match returns the cases whose Name matches the regular expression
+func Match(cases []Case, re *regexp.Regexp) []Case {
+	var out []Case
+	for _, c := range cases {
+		if re.MatchString(c.Name()) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Run measures each case with testing.Benchmark, progress is called after each one if it
+// is not nil.
+func Run(cases []Case, progress func(Result)) []Result {
+	out := make([]Result, 0, len(cases))
+	for _, c := range cases {
+		r := testing.Benchmark(c.F)
+		res := Result{Case: c, AllocsPerOp: r.AllocsPerOp(), BytesPerOp: r.AllocedBytesPerOp()}
+		if r.N > 0 {
+			res.NsPerOp = float64(r.T.Nanoseconds()) / float64(r.N)
+		}
+		if progress != nil {
+			progress(res)
+		}
+		out = append(out, res)
+	}
+	return out
+}
+
+// TableOptions controls WriteTable
+type TableOptions struct {
+	// Baseline is a type, such as float64, which every other type is compared to
+	Baseline string
+
+	// Markdown writes a Markdown table rather than aligned text
+	Markdown bool
+}
+
+// WriteTable writes the results with one row per operation and one column per type, each
+// cell is the time per value followed by the allocations per call and, if there is a
+// baseline, how many times slower than the baseline it is.
+func WriteTable(w io.Writer, results []Result, opt TableOptions) error {
+	var types []string
+	ops := append([]string(nil), Ops...)
+	cells := map[[2]string]Result{}
+	for _, r := range results {
+		if !contains(types, r.Type) {
+			types = append(types, r.Type)
+		}
+		if !contains(ops, r.Op) {
+			ops = append(ops, r.Op)
+		}
+		cells[[2]string{r.Type, r.Op}] = r
+	}
+
+	sep, end := "\t", "\t\n"
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	out := io.Writer(tw)
+	if opt.Markdown {
+		sep, end = " | ", " |\n"
+		out = w
+	}
+	row := func(cols []string) {
+		if opt.Markdown {
+			io.WriteString(out, "| ")
+		}
+		io.WriteString(out, strings.Join(cols, sep)+end)
+	}
+	row(append([]string{"op"}, types...))
+	if opt.Markdown {
+		dashes := make([]string, len(types)+1)
+		for i := range dashes {
+			dashes[i] = "---"
+		}
+		row(dashes)
+	}
+	for _, op := range ops {
+		cols := []string{op}
+		used := false
+		for _, t := range types {
+			r, ok := cells[[2]string{t, op}]
+			if !ok {
+				cols = append(cols, "-")
+				continue
+			}
+			used = true
+			cell := fmt.Sprintf("%s %da", formatNs(r.NsPerValue()), r.AllocsPerOp)
+			if base, ok := cells[[2]string{opt.Baseline, op}]; ok && t != opt.Baseline && base.NsPerValue() > 0 {
+				cell += " x" + formatRatio(r.NsPerValue()/base.NsPerValue())
+			}
+			cols = append(cols, cell)
+		}
+		if used {
+			row(cols)
+		}
+	}
+	if opt.Markdown {
+		return nil
+	}
+	return tw.Flush()
+}
+
+func contains(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+// formatRatio writes a ratio with 3 significant figures but never in exponent form
+func formatRatio(x float64) string {
+	if x >= 100 {
+		return fmt.Sprintf("%.0f", x)
+	}
+	return fmt.Sprintf("%.3g", x)
+}
+
+// formatNs writes a time with 3 significant figures in the unit which suits it
+func formatNs(ns float64) string {
+	switch {
+	case ns >= 1e6:
+		return fmt.Sprintf("%.3gms", ns/1e6)
+	case ns >= 1e3:
+		return fmt.Sprintf("%.3gus", ns/1e3)
+	}
+	return fmt.Sprintf("%.3gns", ns)
+}
@@ -0,0 +1,64 @@
+package bench_test
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/cjdelisle/goposit/bench"
+)
+
+// BenchmarkAll runs every case, for example go test -bench 'All/Posit16/'
+func BenchmarkAll(b *testing.B) {
+	for _, c := range bench.Cases() {
+		b.Run(c.Name(), c.F)
+	}
+}
+
+func TestCases(t *testing.T) {
+	seen := map[string]bool{}
+	for _, c := range bench.Cases() {
+		if seen[c.Name()] {
+			t.Errorf("%v appears twice", c.Name())
+		}
+		seen[c.Name()] = true
+		if c.Lanes < 1 || c.F == nil {
+			t.Errorf("%v is incomplete", c.Name())
+		}
+	}
+	for _, name := range []string{"float64/Add", "float32/MulPromote", "Posit8/Sqrt", "Posit64/MulPromote",
+		"Posit8x4/AddExact", "Posit16x2/Div", "Slow128es4/Int"} {
+		if !seen[name] {
+			t.Errorf("missing %v", name)
+		}
+	}
+	if seen["float64/MulPromote"] {
+		t.Error("float64 has nothing to promote to")
+	}
+	if n := len(bench.Match(bench.Cases(), regexp.MustCompile(`^Posit16/`))); n != len(bench.Ops) {
+		t.Errorf("expected every op for Posit16, got %d", n)
+	}
+}
+
+func TestWriteTable(t *testing.T) {
+	results := []bench.Result{
+		{Case: bench.Case{Type: "float64", Op: "Add", Lanes: 1}, NsPerOp: 2},
+		{Case: bench.Case{Type: "Posit8x4", Op: "Add", Lanes: 4}, NsPerOp: 4000, AllocsPerOp: 12},
+		{Case: bench.Case{Type: "Posit8x4", Op: "Sqrt", Lanes: 4}, NsPerOp: 80},
+	}
+	var buf bytes.Buffer
+	if err := bench.WriteTable(&buf, results, bench.TableOptions{Baseline: "float64"}); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || !strings.Contains(lines[1], "1us 12a x500") || !strings.Contains(lines[2], "-") {
+		t.Errorf("unexpected table:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	bench.WriteTable(&buf, results, bench.TableOptions{Markdown: true})
+	if !strings.HasPrefix(buf.String(), "| op | float64 | Posit8x4 |\n| --- | --- | --- |\n| Add | 2ns 0a | 1us 12a |") {
+		t.Errorf("unexpected markdown:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,51 @@
+
+var GLUE(sink, FLOAT_T) FLOAT_T
+var GLUE(intSink, FLOAT_T) int64
+
+func GLUE(cases, GLUE(Float, FBITS))() []Case {
+    var x [nOperands]FLOAT_T
+    for i, f := range operands() {
+        x[i] = FLOAT_T(f)
+    }
+    binary := func(op string, f func(a, b FLOAT_T) FLOAT_T) Case {
+        return Case{Type: STR(FLOAT_T), Op: op, Lanes: 1, F: func(b *testing.B) {
+            b.ReportAllocs()
+            for i := 0; i < b.N; i++ {
+                GLUE(sink, FLOAT_T) = f(x[i%nOperands], x[(i+1)%nOperands])
+            }
+        }}
+    }
+    return []Case{
+        binary("Add", func(a, b FLOAT_T) FLOAT_T { return a + b }),
+        binary("Sub", func(a, b FLOAT_T) FLOAT_T { return a - b }),
+        binary("Mul", func(a, b FLOAT_T) FLOAT_T { return a * b }),
+        binary("Div", func(a, b FLOAT_T) FLOAT_T { return a / b }),
+        binary("Sqrt", func(a, _ FLOAT_T) FLOAT_T { return FLOAT_T(math.Sqrt(float64(a))) }),
+        __COMMENT__ TwoSum, the rounding error of the sum is computed exactly __NEWLINE__
+        binary("AddExact", func(a, b FLOAT_T) FLOAT_T {
+            s := a + b
+            bb := s - a
+            return (a - (s - bb)) + (b - bb)
+        }),
+#ifdef BIGGER_F
+        {Type: STR(FLOAT_T), Op: "MulPromote", Lanes: 1, F: func(b *testing.B) {
+            b.ReportAllocs()
+            for i := 0; i < b.N; i++ {
+                GLUE(sink, BIGGER_F) = BIGGER_F(x[i%nOperands]) * BIGGER_F(x[(i+1)%nOperands])
+            }
+        }},
+#endif
+        {Type: STR(FLOAT_T), Op: "FromInt", Lanes: 1, F: func(b *testing.B) {
+            b.ReportAllocs()
+            for i := 0; i < b.N; i++ {
+                GLUE(sink, FLOAT_T) = FLOAT_T(int64(i & 0x7f))
+            }
+        }},
+        {Type: STR(FLOAT_T), Op: "Int", Lanes: 1, F: func(b *testing.B) {
+            b.ReportAllocs()
+            for i := 0; i < b.N; i++ {
+                GLUE(intSink, FLOAT_T) = int64(x[i%nOperands])
+            }
+        }},
+    }
+}
@@ -0,0 +1,30 @@
+
+var GLUE(sink, VEC_T) goposit.VEC_T
+
+func GLUE(cases, VEC_T)() []Case {
+    one := goposit.GLUE(New, ONE_T)()
+    bits := operandBits(NBITS, ES)
+    var x [nOperands]goposit.VEC_T
+    for i := range x {
+        x[i] = goposit.GLUE(New, VEC_T)(one)
+        for j := 0; j < WIDTH; j++ {
+            x[i].Put(j, one.SetBits(UWORD(bits[(i+j)%nOperands].Uint64())))
+        }
+    }
+    binary := func(op string, f func(a, b goposit.VEC_T) goposit.VEC_T) Case {
+        return Case{Type: STR(VEC_T), Op: op, Lanes: WIDTH, F: func(b *testing.B) {
+            b.ReportAllocs()
+            for i := 0; i < b.N; i++ {
+                GLUE(sink, VEC_T) = f(x[i%nOperands], x[(i+1)%nOperands])
+            }
+        }}
+    }
+    return []Case{
+        binary("Add", goposit.VEC_T.Add),
+        binary("Sub", goposit.VEC_T.Sub),
+        binary("Mul", goposit.VEC_T.Mul),
+        binary("Div", goposit.VEC_T.Div),
+        binary("Sqrt", func(a, _ goposit.VEC_T) goposit.VEC_T { return a.Sqrt() }),
+        binary("AddExact", func(a, b goposit.VEC_T) goposit.VEC_T { z, _ := a.AddExact(b); return z }),
+    }
+}
@@ -0,0 +1,46 @@
+
+var GLUE(sink, POSIT_T) goposit.POSIT_T
+var GLUE(bigSink, POSIT_T) goposit.BIGGER_T
+var GLUE(intSink, POSIT_T) SWORD
+
+func GLUE(cases, POSIT_T)() []Case {
+    p := goposit.GLUE(New, POSIT_T)()
+    var x [nOperands]goposit.POSIT_T
+    for i, s := range operandBits(NBITS, ES) {
+        x[i] = p.SetBits(UWORD(s.Uint64()))
+    }
+    binary := func(op string, f func(a, b goposit.POSIT_T) goposit.POSIT_T) Case {
+        return Case{Type: STR(POSIT_T), Op: op, Lanes: 1, F: func(b *testing.B) {
+            b.ReportAllocs()
+            for i := 0; i < b.N; i++ {
+                GLUE(sink, POSIT_T) = f(x[i%nOperands], x[(i+1)%nOperands])
+            }
+        }}
+    }
+    return []Case{
+        binary("Add", goposit.POSIT_T.Add),
+        binary("Sub", goposit.POSIT_T.Sub),
+        binary("Mul", goposit.POSIT_T.Mul),
+        binary("Div", goposit.POSIT_T.Div),
+        binary("Sqrt", func(a, _ goposit.POSIT_T) goposit.POSIT_T { return a.Sqrt() }),
+        binary("AddExact", func(a, b goposit.POSIT_T) goposit.POSIT_T { z, _ := a.AddExact(b); return z }),
+        {Type: STR(POSIT_T), Op: "MulPromote", Lanes: 1, F: func(b *testing.B) {
+            b.ReportAllocs()
+            for i := 0; i < b.N; i++ {
+                GLUE(bigSink, POSIT_T) = x[i%nOperands].MulPromote(x[(i+1)%nOperands])
+            }
+        }},
+        {Type: STR(POSIT_T), Op: "FromInt", Lanes: 1, F: func(b *testing.B) {
+            b.ReportAllocs()
+            for i := 0; i < b.N; i++ {
+                GLUE(sink, POSIT_T) = p.FromInt(SWORD(i & 0x7f))
+            }
+        }},
+        {Type: STR(POSIT_T), Op: "Int", Lanes: 1, F: func(b *testing.B) {
+            b.ReportAllocs()
+            for i := 0; i < b.N; i++ {
+                GLUE(intSink, POSIT_T) = x[i%nOperands].Int()
+            }
+        }},
+    }
+}
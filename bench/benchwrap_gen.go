@@ -0,0 +1,345 @@
+package bench
+
+import (
+	"github.com/cjdelisle/goposit"
+	"math"
+	"testing"
+)
+
+var sinkfloat64 float64
+var intSinkfloat64 int64
+
+func casesFloat64() []Case {
+	var x [nOperands]float64
+	for i, f := range operands() {
+		x[i] = float64(f)
+	}
+	binary := func(op string, f func(a, b float64) float64) Case {
+		return Case{Type: "float64", Op: op, Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkfloat64 = f(x[i%nOperands], x[(i+1)%nOperands])
+			}
+		}}
+	}
+	return []Case{
+		binary("Add", func(a, b float64) float64 { return a + b }),
+		binary("Sub", func(a, b float64) float64 { return a - b }),
+		binary("Mul", func(a, b float64) float64 { return a * b }),
+		binary("Div", func(a, b float64) float64 { return a / b }),
+		binary("Sqrt", func(a, _ float64) float64 { return float64(math.Sqrt(float64(a))) }),
+		// TwoSum, the rounding error of the sum is computed exactly
+
+		binary("AddExact", func(a, b float64) float64 {
+			s := a + b
+			bb := s - a
+			return (a - (s - bb)) + (b - bb)
+		}),
+		{Type: "float64", Op: "FromInt", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkfloat64 = float64(int64(i & 0x7f))
+			}
+		}},
+		{Type: "float64", Op: "Int", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				intSinkfloat64 = int64(x[i%nOperands])
+			}
+		}},
+	}
+}
+
+var sinkfloat32 float32
+var intSinkfloat32 int64
+
+func casesFloat32() []Case {
+	var x [nOperands]float32
+	for i, f := range operands() {
+		x[i] = float32(f)
+	}
+	binary := func(op string, f func(a, b float32) float32) Case {
+		return Case{Type: "float32", Op: op, Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkfloat32 = f(x[i%nOperands], x[(i+1)%nOperands])
+			}
+		}}
+	}
+	return []Case{
+		binary("Add", func(a, b float32) float32 { return a + b }),
+		binary("Sub", func(a, b float32) float32 { return a - b }),
+		binary("Mul", func(a, b float32) float32 { return a * b }),
+		binary("Div", func(a, b float32) float32 { return a / b }),
+		binary("Sqrt", func(a, _ float32) float32 { return float32(math.Sqrt(float64(a))) }),
+		// TwoSum, the rounding error of the sum is computed exactly
+
+		binary("AddExact", func(a, b float32) float32 {
+			s := a + b
+			bb := s - a
+			return (a - (s - bb)) + (b - bb)
+		}),
+		{Type: "float32", Op: "MulPromote", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkfloat64 = float64(x[i%nOperands]) * float64(x[(i+1)%nOperands])
+			}
+		}},
+		{Type: "float32", Op: "FromInt", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkfloat32 = float32(int64(i & 0x7f))
+			}
+		}},
+		{Type: "float32", Op: "Int", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				intSinkfloat32 = int64(x[i%nOperands])
+			}
+		}},
+	}
+}
+
+var sinkPosit8 goposit.Posit8
+var bigSinkPosit8 goposit.Posit16
+var intSinkPosit8 int8
+
+func casesPosit8() []Case {
+	p := goposit.NewPosit8()
+	var x [nOperands]goposit.Posit8
+	for i, s := range operandBits(8, 0) {
+		x[i] = p.SetBits(uint8(s.Uint64()))
+	}
+	binary := func(op string, f func(a, b goposit.Posit8) goposit.Posit8) Case {
+		return Case{Type: "Posit8", Op: op, Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkPosit8 = f(x[i%nOperands], x[(i+1)%nOperands])
+			}
+		}}
+	}
+	return []Case{
+		binary("Add", goposit.Posit8.Add),
+		binary("Sub", goposit.Posit8.Sub),
+		binary("Mul", goposit.Posit8.Mul),
+		binary("Div", goposit.Posit8.Div),
+		binary("Sqrt", func(a, _ goposit.Posit8) goposit.Posit8 { return a.Sqrt() }),
+		binary("AddExact", func(a, b goposit.Posit8) goposit.Posit8 { z, _ := a.AddExact(b); return z }),
+		{Type: "Posit8", Op: "MulPromote", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bigSinkPosit8 = x[i%nOperands].MulPromote(x[(i+1)%nOperands])
+			}
+		}},
+		{Type: "Posit8", Op: "FromInt", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkPosit8 = p.FromInt(int8(i & 0x7f))
+			}
+		}},
+		{Type: "Posit8", Op: "Int", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				intSinkPosit8 = x[i%nOperands].Int()
+			}
+		}},
+	}
+}
+
+var sinkPosit16 goposit.Posit16
+var bigSinkPosit16 goposit.Posit32
+var intSinkPosit16 int16
+
+func casesPosit16() []Case {
+	p := goposit.NewPosit16()
+	var x [nOperands]goposit.Posit16
+	for i, s := range operandBits(16, 1) {
+		x[i] = p.SetBits(uint16(s.Uint64()))
+	}
+	binary := func(op string, f func(a, b goposit.Posit16) goposit.Posit16) Case {
+		return Case{Type: "Posit16", Op: op, Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkPosit16 = f(x[i%nOperands], x[(i+1)%nOperands])
+			}
+		}}
+	}
+	return []Case{
+		binary("Add", goposit.Posit16.Add),
+		binary("Sub", goposit.Posit16.Sub),
+		binary("Mul", goposit.Posit16.Mul),
+		binary("Div", goposit.Posit16.Div),
+		binary("Sqrt", func(a, _ goposit.Posit16) goposit.Posit16 { return a.Sqrt() }),
+		binary("AddExact", func(a, b goposit.Posit16) goposit.Posit16 { z, _ := a.AddExact(b); return z }),
+		{Type: "Posit16", Op: "MulPromote", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bigSinkPosit16 = x[i%nOperands].MulPromote(x[(i+1)%nOperands])
+			}
+		}},
+		{Type: "Posit16", Op: "FromInt", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkPosit16 = p.FromInt(int16(i & 0x7f))
+			}
+		}},
+		{Type: "Posit16", Op: "Int", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				intSinkPosit16 = x[i%nOperands].Int()
+			}
+		}},
+	}
+}
+
+var sinkPosit32 goposit.Posit32
+var bigSinkPosit32 goposit.Posit64
+var intSinkPosit32 int32
+
+func casesPosit32() []Case {
+	p := goposit.NewPosit32()
+	var x [nOperands]goposit.Posit32
+	for i, s := range operandBits(32, 2) {
+		x[i] = p.SetBits(uint32(s.Uint64()))
+	}
+	binary := func(op string, f func(a, b goposit.Posit32) goposit.Posit32) Case {
+		return Case{Type: "Posit32", Op: op, Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkPosit32 = f(x[i%nOperands], x[(i+1)%nOperands])
+			}
+		}}
+	}
+	return []Case{
+		binary("Add", goposit.Posit32.Add),
+		binary("Sub", goposit.Posit32.Sub),
+		binary("Mul", goposit.Posit32.Mul),
+		binary("Div", goposit.Posit32.Div),
+		binary("Sqrt", func(a, _ goposit.Posit32) goposit.Posit32 { return a.Sqrt() }),
+		binary("AddExact", func(a, b goposit.Posit32) goposit.Posit32 { z, _ := a.AddExact(b); return z }),
+		{Type: "Posit32", Op: "MulPromote", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bigSinkPosit32 = x[i%nOperands].MulPromote(x[(i+1)%nOperands])
+			}
+		}},
+		{Type: "Posit32", Op: "FromInt", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkPosit32 = p.FromInt(int32(i & 0x7f))
+			}
+		}},
+		{Type: "Posit32", Op: "Int", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				intSinkPosit32 = x[i%nOperands].Int()
+			}
+		}},
+	}
+}
+
+var sinkPosit64 goposit.Posit64
+var bigSinkPosit64 goposit.Posit128
+var intSinkPosit64 int64
+
+func casesPosit64() []Case {
+	p := goposit.NewPosit64()
+	var x [nOperands]goposit.Posit64
+	for i, s := range operandBits(64, 3) {
+		x[i] = p.SetBits(uint64(s.Uint64()))
+	}
+	binary := func(op string, f func(a, b goposit.Posit64) goposit.Posit64) Case {
+		return Case{Type: "Posit64", Op: op, Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkPosit64 = f(x[i%nOperands], x[(i+1)%nOperands])
+			}
+		}}
+	}
+	return []Case{
+		binary("Add", goposit.Posit64.Add),
+		binary("Sub", goposit.Posit64.Sub),
+		binary("Mul", goposit.Posit64.Mul),
+		binary("Div", goposit.Posit64.Div),
+		binary("Sqrt", func(a, _ goposit.Posit64) goposit.Posit64 { return a.Sqrt() }),
+		binary("AddExact", func(a, b goposit.Posit64) goposit.Posit64 { z, _ := a.AddExact(b); return z }),
+		{Type: "Posit64", Op: "MulPromote", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bigSinkPosit64 = x[i%nOperands].MulPromote(x[(i+1)%nOperands])
+			}
+		}},
+		{Type: "Posit64", Op: "FromInt", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkPosit64 = p.FromInt(int64(i & 0x7f))
+			}
+		}},
+		{Type: "Posit64", Op: "Int", Lanes: 1, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				intSinkPosit64 = x[i%nOperands].Int()
+			}
+		}},
+	}
+}
+
+var sinkPosit8x4 goposit.Posit8x4
+
+func casesPosit8x4() []Case {
+	one := goposit.NewPosit8()
+	bits := operandBits(8, 0)
+	var x [nOperands]goposit.Posit8x4
+	for i := range x {
+		x[i] = goposit.NewPosit8x4(one)
+		for j := 0; j < 4; j++ {
+			x[i].Put(j, one.SetBits(uint8(bits[(i+j)%nOperands].Uint64())))
+		}
+	}
+	binary := func(op string, f func(a, b goposit.Posit8x4) goposit.Posit8x4) Case {
+		return Case{Type: "Posit8x4", Op: op, Lanes: 4, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkPosit8x4 = f(x[i%nOperands], x[(i+1)%nOperands])
+			}
+		}}
+	}
+	return []Case{
+		binary("Add", goposit.Posit8x4.Add),
+		binary("Sub", goposit.Posit8x4.Sub),
+		binary("Mul", goposit.Posit8x4.Mul),
+		binary("Div", goposit.Posit8x4.Div),
+		binary("Sqrt", func(a, _ goposit.Posit8x4) goposit.Posit8x4 { return a.Sqrt() }),
+		binary("AddExact", func(a, b goposit.Posit8x4) goposit.Posit8x4 { z, _ := a.AddExact(b); return z }),
+	}
+}
+
+var sinkPosit16x2 goposit.Posit16x2
+
+func casesPosit16x2() []Case {
+	one := goposit.NewPosit16()
+	bits := operandBits(16, 1)
+	var x [nOperands]goposit.Posit16x2
+	for i := range x {
+		x[i] = goposit.NewPosit16x2(one)
+		for j := 0; j < 2; j++ {
+			x[i].Put(j, one.SetBits(uint16(bits[(i+j)%nOperands].Uint64())))
+		}
+	}
+	binary := func(op string, f func(a, b goposit.Posit16x2) goposit.Posit16x2) Case {
+		return Case{Type: "Posit16x2", Op: op, Lanes: 2, F: func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkPosit16x2 = f(x[i%nOperands], x[(i+1)%nOperands])
+			}
+		}}
+	}
+	return []Case{
+		binary("Add", goposit.Posit16x2.Add),
+		binary("Sub", goposit.Posit16x2.Sub),
+		binary("Mul", goposit.Posit16x2.Mul),
+		binary("Div", goposit.Posit16x2.Div),
+		binary("Sqrt", func(a, _ goposit.Posit16x2) goposit.Posit16x2 { return a.Sqrt() }),
+		binary("AddExact", func(a, b goposit.Posit16x2) goposit.Posit16x2 { z, _ := a.AddExact(b); return z }),
+	}
+}
@@ -0,0 +1,56 @@
+package goposit
+
+import (
+	"math/big"
+)
+
+// FromBigInt creates a new posit which is set to the value of i rounded to nearest even,
+// numbers larger than maxpos saturate to maxpos.
+// p.FromBigInt() outputs a new posit z, p is not altered
+func (p *SlowPosit) FromBigInt(i *big.Int) *SlowPosit {
+	f := new(big.Float).SetPrec(uint(max(i.BitLen(), 1))).SetInt(i)
+	return outPosit(p, f)
+}
+
+// FromRat creates a new posit which is set to the value of r rounded to nearest even,
+// rounding only once. Numbers larger than maxpos saturate to maxpos and numbers which are
+// not zero but smaller than minpos become minpos.
+// p.FromRat() outputs a new posit z, p is not altered
+func (p *SlowPosit) FromRat(r *big.Rat) *SlowPosit {
+	return NewSlowPosit(p.nbits, p.es).fromRat(r)
+}
+
+// FromBigFloat creates a new posit which is set to the value of f rounded to nearest even,
+// with the same saturation as FromRat, an infinite f becomes NaR.
+// p.FromBigFloat() outputs a new posit z, p is not altered
+func (p *SlowPosit) FromBigFloat(f *big.Float) *SlowPosit {
+	return outPosit(p, f)
+}
+
+// BigInt returns the value of the posit rounded to the nearest integer, ties to even,
+// or nil if the posit is NaR. There is no saturation, the largest posits give integers
+// of up to Log2MaxVal bits.
+func (p *SlowPosit) BigInt() *big.Int {
+	if p.IsNaR() {
+		return nil
+	}
+	return roundEven(p.ToFloat())
+}
+
+// Rat returns the exact value of the posit, or nil if it is NaR
+func (p *SlowPosit) Rat() *big.Rat {
+	if p.IsNaR() {
+		return nil
+	}
+	r, _ := p.ToFloat().Rat(nil)
+	return r
+}
+
+// BigFloat returns the exact value of the posit, or nil if it is NaR, unlike ToFloat
+// which gives NaR as infinity.
+func (p *SlowPosit) BigFloat() *big.Float {
+	if p.IsNaR() {
+		return nil
+	}
+	return p.ToFloat()
+}
@@ -0,0 +1,98 @@
+package goposit_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+func TestBigInt(t *testing.T) {
+	p := goposit.NewPosit64()
+	two70 := new(big.Int).Lsh(big.NewInt(1), 70)
+	x := p.FromBigInt(two70)
+	if x.BigInt().Cmp(two70) != 0 {
+		t.Errorf("2**70 should be exact in Posit64, got %v", x.BigInt())
+	}
+	if x.Int() != 0x7fffffffffffffff {
+		t.Errorf("Int should saturate, got %x", x.Int())
+	}
+	huge := new(big.Int).Lsh(big.NewInt(-1), 600)
+	if got := p.FromBigInt(huge); got.Bits() != goposit.Posit64MaxPos().Bits()^0xffffffffffffffff+1 {
+		t.Errorf("-2**600 should saturate to -maxpos, got %x", got.Bits())
+	}
+	if got := p.FromBigInt(new(big.Int)); got.Bits() != 0 {
+		t.Errorf("zero should be zero, got %x", got.Bits())
+	}
+
+	// ties round to even
+	for _, c := range []struct {
+		f    float64
+		want int64
+	}{{2.5, 2}, {3.5, 4}, {-2.5, -2}, {0.5, 0}, {-0.75, -1}} {
+		if got := p16(c.f).BigInt(); got.Int64() != c.want {
+			t.Errorf("%v should round to %v, got %v", c.f, c.want, got)
+		}
+	}
+	if goposit.Posit16NaR().BigInt() != nil {
+		t.Error("NaR should have no integer value")
+	}
+}
+
+func TestRat(t *testing.T) {
+	// every Posit16 converts to a rational and back exactly
+	p := goposit.NewPosit16()
+	for i := 0; i < 1<<16; i++ {
+		x := p.SetBits(uint16(i))
+		r := x.Rat()
+		if i == 0x8000 {
+			if r != nil || x.BigFloat() != nil {
+				t.Error("NaR should have no value")
+			}
+			continue
+		}
+		if p.FromRat(r).Bits() != x.Bits() || p.FromBigFloat(x.BigFloat()).Bits() != x.Bits() {
+			t.Fatalf("%04x did not round trip", i)
+		}
+		if f, _ := x.BigFloat().Rat(nil); f.Cmp(r) != 0 {
+			t.Fatalf("%04x BigFloat and Rat differ", i)
+		}
+	}
+
+	// rounding is to nearest even and only once
+	third := p.FromRat(big.NewRat(1, 3))
+	if third.Bits() != p.FromInt(1).Div(p.FromInt(3)).Bits() {
+		t.Errorf("1/3 should round like 1 / 3, got %x", third.Bits())
+	}
+	if got := p.FromRat(big.NewRat(1, 1<<40)); got.Bits() != goposit.Posit16MinPos().Bits() {
+		t.Errorf("a tiny number should become minpos, got %x", got.Bits())
+	}
+	if got := p.FromBigFloat(new(big.Float).SetInf(false)); got.Bits() != goposit.Posit16NaR().Bits() {
+		t.Errorf("infinity should become NaR, got %x", got.Bits())
+	}
+
+	// Posit128 holds an exact product which can be read back
+	a := goposit.NewPosit64().FromRat(big.NewRat(1, 3))
+	b := goposit.NewPosit64().FromRat(big.NewRat(2, 7))
+	want := new(big.Rat).Mul(a.Rat(), b.Rat())
+	if got := a.MulPromote(b).Rat(); got.Cmp(want) != 0 {
+		t.Errorf("the promoted product should be exact, got %v want %v", got, want)
+	}
+}
+
+func TestSlowBigConversions(t *testing.T) {
+	p := goposit.NewSlowPosit(100, 4)
+	r := big.NewRat(-355, 113)
+	x := p.FromRat(r)
+	f := new(big.Float).SetPrec(200).SetRat(r)
+	if x.Cmp(p.FromBigFloat(f)) != 0 {
+		t.Error("FromRat and FromBigFloat should agree")
+	}
+	if x.BigInt().Int64() != -3 || x.Rat().Sign() >= 0 || x.BigFloat().Sign() >= 0 {
+		t.Errorf("unexpected conversions of %v", x.BigFloat())
+	}
+	n := new(big.Int).Exp(big.NewInt(10), big.NewInt(25), nil)
+	if p.FromBigInt(n).BigInt().Cmp(n) != 0 {
+		t.Error("10**25 should be exact in 100 bits")
+	}
+}
@@ -0,0 +1,312 @@
+package goposit
+
+import (
+	"math/big"
+)
+
+// Elementary functions on big.Float, these are used to compute posit results: each
+// function computes the result with guard bits beyond prec so that, except in very rare
+// cases where the true value is extremely close to a rounding boundary, converting the
+// result with FromFloat gives the correctly rounded posit.
+
+const bigGuardBits = 64
+
+func newBig(prec uint) *big.Float {
+	return new(big.Float).SetPrec(prec).SetMode(big.ToNearestEven)
+}
+
+// atanInv computes atan(1/n) using the Taylor series, for use with Machin's formula
+func atanInv(n int64, prec uint) *big.Float {
+	x := newBig(prec).SetInt64(1)
+	x.Quo(x, newBig(prec).SetInt64(n))
+	x2 := newBig(prec).Mul(x, x)
+	sum := newBig(prec).Set(x)
+	term := newBig(prec).Set(x)
+	for k := int64(1); ; k++ {
+		term.Mul(term, x2)
+		t := newBig(prec).Quo(term, newBig(prec).SetInt64(2*k+1))
+		if t.Sign() == 0 || t.MantExp(nil) < sum.MantExp(nil)-int(prec)-2 {
+			break
+		}
+		if k%2 == 1 {
+			sum.Sub(sum, t)
+		} else {
+			sum.Add(sum, t)
+		}
+	}
+	return sum
+}
+
+// BigPi returns pi with prec bits of precision
+func BigPi(prec uint) *big.Float {
+	wp := prec + bigGuardBits
+	// Machin: pi = 16*atan(1/5) - 4*atan(1/239)
+	a := atanInv(5, wp)
+	Shf(a, 4)
+	b := atanInv(239, wp)
+	Shf(b, 2)
+	a.Sub(a, b)
+	return newBig(prec).Set(a)
+}
+
+// BigLn2 returns the natural log of 2 with prec bits of precision
+func BigLn2(prec uint) *big.Float {
+	wp := prec + bigGuardBits
+	// ln(2) = 2*atanh(1/3)
+	third := newBig(wp).SetInt64(1)
+	third.Quo(third, newBig(wp).SetInt64(3))
+	out := atanhSeries(third, wp)
+	Shf(out, 1)
+	return newBig(prec).Set(out)
+}
+
+// atanhSeries computes atanh(x) for small |x| using the Taylor series
+func atanhSeries(x *big.Float, prec uint) *big.Float {
+	x2 := newBig(prec).Mul(x, x)
+	sum := newBig(prec).Set(x)
+	term := newBig(prec).Set(x)
+	if x.Sign() == 0 {
+		return sum
+	}
+	for k := int64(1); ; k++ {
+		term.Mul(term, x2)
+		t := newBig(prec).Quo(term, newBig(prec).SetInt64(2*k+1))
+		if t.Sign() == 0 || t.MantExp(nil) < sum.MantExp(nil)-int(prec)-2 {
+			break
+		}
+		sum.Add(sum, t)
+	}
+	return sum
+}
+
+// BigExp returns e**x with prec bits of precision. Because posits saturate rather than
+// overflowing, if the result would be outside of the range of big.Float it is clamped
+// to a very large (or very small) finite number.
+func BigExp(x *big.Float, prec uint) *big.Float {
+	if x.IsInf() {
+		if x.Sign() > 0 {
+			return newBig(prec).SetInf(false)
+		}
+		return newBig(prec)
+	}
+	if x.Sign() == 0 {
+		return newBig(prec).SetInt64(1)
+	}
+	const limit = 1 << 24
+	if x.MantExp(nil) > 26 {
+		out := newBig(prec).SetInt64(1)
+		if x.Sign() > 0 {
+			return out.SetMantExp(out, limit)
+		}
+		return out.SetMantExp(out, -limit)
+	}
+
+	// x = k*ln2 + r where |r| <= ln2/2
+	wp := prec + bigGuardBits + 32
+	ln2 := BigLn2(wp)
+	kf := newBig(wp).Quo(x, ln2)
+	k, _ := kf.Int64()
+	if kf.Sign() > 0 {
+		kf.Sub(kf, newBig(wp).SetInt64(k))
+		if kf.Cmp(big.NewFloat(0.5)) > 0 {
+			k++
+		}
+	} else {
+		kf.Sub(kf, newBig(wp).SetInt64(k))
+		if kf.Cmp(big.NewFloat(-0.5)) < 0 {
+			k--
+		}
+	}
+	r := newBig(wp).Mul(ln2, newBig(wp).SetInt64(k))
+	r.Sub(x, r)
+
+	// make r small so the series converges quickly, then square the result back up
+	const halvings = 16
+	Shf(r, -halvings)
+	sum := newBig(wp).SetInt64(1)
+	term := newBig(wp).SetInt64(1)
+	for n := int64(1); ; n++ {
+		term.Mul(term, r)
+		term.Quo(term, newBig(wp).SetInt64(n))
+		if term.Sign() == 0 || term.MantExp(nil) < -int(wp)-2 {
+			break
+		}
+		sum.Add(sum, term)
+	}
+	for i := 0; i < halvings; i++ {
+		sum.Mul(sum, sum)
+	}
+	Shf(sum, int(k))
+	return newBig(prec).Set(sum)
+}
+
+// BigLog returns the natural logarithm of x with prec bits of precision, x must be
+// positive. The log of zero is -Inf.
+func BigLog(x *big.Float, prec uint) *big.Float {
+	if x.Sign() < 0 {
+		panic("log of a negative number")
+	}
+	if x.Sign() == 0 {
+		return newBig(prec).SetInf(true)
+	}
+	if x.IsInf() {
+		return newBig(prec).SetInf(false)
+	}
+	wp := prec + bigGuardBits + 32
+	// x = m * 2**e, ln(x) = e*ln(2) + 2*atanh((m-1)/(m+1))
+	// m is kept between sqrt(1/2) and sqrt(2) so numbers close to 1 do not cancel
+	m := newBig(wp)
+	e := x.MantExp(m)
+	if m.Cmp(big.NewFloat(0.7071067811865476)) < 0 {
+		Shf(m, 1)
+		e--
+	}
+	num := newBig(wp).Sub(m, big.NewFloat(1))
+	den := newBig(wp).Add(m, big.NewFloat(1))
+	num.Quo(num, den)
+	out := atanhSeries(num, wp)
+	Shf(out, 1)
+	if e != 0 {
+		l := BigLn2(wp)
+		l.Mul(l, newBig(wp).SetInt64(int64(e)))
+		out.Add(out, l)
+	}
+	return newBig(prec).Set(out)
+}
+
+// BigSinCos returns the sine and cosine of x with prec bits of precision
+func BigSinCos(x *big.Float, prec uint) (sin, cos *big.Float) {
+	if x.Sign() == 0 {
+		return newBig(prec), newBig(prec).SetInt64(1)
+	}
+	if x.IsInf() {
+		panic("sin/cos of an infinite number")
+	}
+	// The reduction x - k*pi/2 needs as many extra bits as the exponent of x
+	wp := prec + bigGuardBits
+	if e := x.MantExp(nil); e > 0 {
+		wp += uint(e)
+	}
+	halfPi := BigPi(wp)
+	Shf(halfPi, -1)
+	kf := newBig(wp).Quo(x, halfPi)
+	ki, _ := kf.Int(nil)
+	kf.Sub(kf, newBig(wp).SetInt(ki))
+	if kf.Cmp(big.NewFloat(0.5)) > 0 {
+		ki.Add(ki, bigi1)
+	} else if kf.Cmp(big.NewFloat(-0.5)) < 0 {
+		ki.Sub(ki, bigi1)
+	}
+	r := newBig(wp).Mul(halfPi, newBig(wp).SetInt(ki))
+	r.Sub(x, r)
+
+	// Taylor series for |r| <= pi/4
+	r2 := newBig(wp).Mul(r, r)
+	s := newBig(wp).Set(r)
+	c := newBig(wp).SetInt64(1)
+	sterm := newBig(wp).Set(r)
+	cterm := newBig(wp).SetInt64(1)
+	for n := int64(1); ; n++ {
+		sterm.Mul(sterm, r2)
+		sterm.Quo(sterm, newBig(wp).SetInt64((2*n)*(2*n+1)))
+		cterm.Mul(cterm, r2)
+		cterm.Quo(cterm, newBig(wp).SetInt64((2*n-1)*(2*n)))
+		if cterm.Sign() == 0 || cterm.MantExp(nil) < -int(wp)-2 {
+			break
+		}
+		if n%2 == 1 {
+			s.Sub(s, sterm)
+			c.Sub(c, cterm)
+		} else {
+			s.Add(s, sterm)
+			c.Add(c, cterm)
+		}
+	}
+
+	quadrant := new(big.Int).And(ki, big.NewInt(3)).Int64()
+	switch quadrant {
+	case 1:
+		s, c = c, s.Neg(s)
+	case 2:
+		s, c = s.Neg(s), c.Neg(c)
+	case 3:
+		s, c = c.Neg(c), s
+	}
+	return newBig(prec).Set(s), newBig(prec).Set(c)
+}
+
+// bigAtan computes atan(x) for finite x
+func bigAtan(x *big.Float, prec uint) *big.Float {
+	wp := prec + bigGuardBits
+	if x.Sign() == 0 {
+		return newBig(prec)
+	}
+	neg := x.Sign() < 0
+	a := newBig(wp).Abs(x)
+	invert := a.Cmp(big.NewFloat(1)) > 0
+	if invert {
+		a.Quo(newBig(wp).SetInt64(1), a)
+	}
+	// atan(a) = 2*atan(a / (1 + sqrt(1 + a*a))), reduce until the series converges fast
+	const halvings = 8
+	for i := 0; i < halvings; i++ {
+		d := newBig(wp).Mul(a, a)
+		d.Add(d, big.NewFloat(1))
+		d.Sqrt(d)
+		d.Add(d, big.NewFloat(1))
+		a.Quo(a, d)
+	}
+	a2 := newBig(wp).Mul(a, a)
+	sum := newBig(wp).Set(a)
+	term := newBig(wp).Set(a)
+	for k := int64(1); ; k++ {
+		term.Mul(term, a2)
+		t := newBig(wp).Quo(term, newBig(wp).SetInt64(2*k+1))
+		if t.Sign() == 0 || t.MantExp(nil) < sum.MantExp(nil)-int(wp)-2 {
+			break
+		}
+		if k%2 == 1 {
+			sum.Sub(sum, t)
+		} else {
+			sum.Add(sum, t)
+		}
+	}
+	Shf(sum, halvings)
+	if invert {
+		halfPi := BigPi(wp)
+		Shf(halfPi, -1)
+		sum.Sub(halfPi, sum)
+	}
+	if neg {
+		sum.Neg(sum)
+	}
+	return newBig(prec).Set(sum)
+}
+
+// BigAtan2 returns the arc tangent of y/x using the signs of the two to determine the
+// quadrant, with prec bits of precision.
+func BigAtan2(y, x *big.Float, prec uint) *big.Float {
+	wp := prec + bigGuardBits
+	if x.Sign() == 0 {
+		if y.Sign() == 0 {
+			return newBig(prec)
+		}
+		halfPi := BigPi(wp)
+		Shf(halfPi, -1)
+		if y.Sign() < 0 {
+			halfPi.Neg(halfPi)
+		}
+		return newBig(prec).Set(halfPi)
+	}
+	q := newBig(wp).Quo(y, x)
+	out := bigAtan(q, wp)
+	if x.Sign() < 0 {
+		pi := BigPi(wp)
+		if y.Sign() < 0 {
+			out.Sub(out, pi)
+		} else {
+			out.Add(out, pi)
+		}
+	}
+	return newBig(prec).Set(out)
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/cjdelisle/goposit"
+)
+
+// evaluator computes expressions in posit arithmetic, every number is rounded to the
+// format when it is read and the result of every operation is rounded, exactly as a
+// program using the posit type would see it.
+//
+//	expr    = term { ("+" | "-") term }
+//	term    = unary { ("*" | "/") unary }
+//	unary   = ("-" | "+") unary | primary
+//	primary = number | 0xBITS | name | name "(" expr ")" | "(" expr ")"
+type evaluator struct {
+	f   format
+	ans *goposit.SlowPosit
+
+	toks []string
+	pos  int
+}
+
+var constants = map[string]func(p *goposit.SlowPosit) *goposit.SlowPosit{
+	"pi":     (*goposit.SlowPosit).Pi,
+	"e":      (*goposit.SlowPosit).E,
+	"ln2":    (*goposit.SlowPosit).Ln2,
+	"sqrt2":  (*goposit.SlowPosit).Sqrt2,
+	"nar":    (*goposit.SlowPosit).NaR,
+	"maxpos": (*goposit.SlowPosit).Max,
+	"minpos": (*goposit.SlowPosit).Min,
+}
+
+var functions = map[string]func(p *goposit.SlowPosit) *goposit.SlowPosit{
+	"sqrt": (*goposit.SlowPosit).Sqrt,
+	"next": (*goposit.SlowPosit).NextUp,
+	"prev": (*goposit.SlowPosit).NextDown,
+	"ulp":  (*goposit.SlowPosit).ULP,
+	"abs": func(p *goposit.SlowPosit) *goposit.SlowPosit {
+		if !p.IsNaR() && p.ToFloat().Sign() < 0 {
+			return neg(p)
+		}
+		return p
+	},
+}
+
+func neg(p *goposit.SlowPosit) *goposit.SlowPosit {
+	return goposit.NewSlowPosit(p.Nbits(), p.Es()).Sub(p)
+}
+
+// eval computes an expression, on success the result is also remembered as ans
+func (e *evaluator) eval(s string) (*goposit.SlowPosit, error) {
+	toks, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(toks) == 0 {
+		return nil, fmt.Errorf("empty expression")
+	}
+	e.toks, e.pos = toks, 0
+	out, err := e.expr()
+	if err == nil && e.pos < len(e.toks) {
+		err = fmt.Errorf("unexpected %q", e.toks[e.pos])
+	}
+	if err != nil {
+		return nil, err
+	}
+	e.ans = out
+	return out, nil
+}
+
+func (e *evaluator) peek() string {
+	if e.pos < len(e.toks) {
+		return e.toks[e.pos]
+	}
+	return ""
+}
+
+func (e *evaluator) next() string {
+	t := e.peek()
+	e.pos++
+	return t
+}
+
+func (e *evaluator) expr() (*goposit.SlowPosit, error) {
+	x, err := e.term()
+	for err == nil && (e.peek() == "+" || e.peek() == "-") {
+		op := e.next()
+		var y *goposit.SlowPosit
+		if y, err = e.term(); err != nil {
+			break
+		}
+		if op == "+" {
+			x = x.Add(y)
+		} else {
+			x = x.Sub(y)
+		}
+	}
+	return x, err
+}
+
+func (e *evaluator) term() (*goposit.SlowPosit, error) {
+	x, err := e.unary()
+	for err == nil && (e.peek() == "*" || e.peek() == "/") {
+		op := e.next()
+		var y *goposit.SlowPosit
+		if y, err = e.unary(); err != nil {
+			break
+		}
+		if op == "*" {
+			x = x.Mul(y)
+		} else {
+			x = x.Div(y)
+		}
+	}
+	return x, err
+}
+
+func (e *evaluator) unary() (*goposit.SlowPosit, error) {
+	switch e.peek() {
+	case "-":
+		e.next()
+		x, err := e.unary()
+		if err != nil {
+			return nil, err
+		}
+		return neg(x), nil
+	case "+":
+		e.next()
+		return e.unary()
+	}
+	return e.primary()
+}
+
+func (e *evaluator) primary() (*goposit.SlowPosit, error) {
+	t := e.next()
+	switch {
+	case t == "":
+		return nil, fmt.Errorf("unexpected end of expression")
+	case t == "(":
+		x, err := e.expr()
+		if err != nil {
+			return nil, err
+		}
+		if e.next() != ")" {
+			return nil, fmt.Errorf("missing )")
+		}
+		return x, nil
+	case unicode.IsLetter(rune(t[0])):
+		name := strings.ToLower(t)
+		if f, ok := functions[name]; ok {
+			if e.next() != "(" {
+				return nil, fmt.Errorf("%v needs an argument in brackets", t)
+			}
+			x, err := e.expr()
+			if err != nil {
+				return nil, err
+			}
+			if e.next() != ")" {
+				return nil, fmt.Errorf("missing )")
+			}
+			return f(x), nil
+		}
+		if c, ok := constants[name]; ok {
+			return c(e.f.new()), nil
+		}
+		if name == "ans" {
+			if e.ans == nil {
+				return nil, fmt.Errorf("there is no previous answer")
+			}
+			if e.ans.Nbits() != e.f.nbits || e.ans.Es() != e.f.es {
+				return e.f.fromFloat(e.ans.ToFloat()), nil
+			}
+			return e.ans, nil
+		}
+		return nil, fmt.Errorf("unknown name %q", t)
+	case len(t) > 2 && strings.EqualFold(t[:2], "0x"):
+		return e.f.fromBits(t)
+	}
+	// the text form rounds a decimal exactly, with no intermediate float
+	p := e.f.new()
+	if err := p.UnmarshalText([]byte(fmt.Sprintf("%d,%d:%s", e.f.nbits, e.f.es, t))); err != nil {
+		return nil, fmt.Errorf("%q is not a number", t)
+	}
+	return p, nil
+}
+
+// tokenize splits an expression into numbers, names, operators and brackets
+func tokenize(s string) ([]string, error) {
+	var out []string
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case strings.IndexByte("+-*/()", c) >= 0:
+			out = append(out, s[i:i+1])
+			i++
+		case c == '0' && i+1 < len(s) && (s[i+1] == 'x' || s[i+1] == 'X'):
+			j := i + 2
+			for j < len(s) && (isHex(s[j]) || s[j] == '_') {
+				j++
+			}
+			out = append(out, s[i:j])
+			i = j
+		case c >= '0' && c <= '9' || c == '.':
+			j := i
+			for j < len(s) && (s[j] >= '0' && s[j] <= '9' || s[j] == '.') {
+				j++
+			}
+			if j < len(s) && (s[j] == 'e' || s[j] == 'E') {
+				k := j + 1
+				if k < len(s) && (s[k] == '+' || s[k] == '-') {
+					k++
+				}
+				if k < len(s) && s[k] >= '0' && s[k] <= '9' {
+					for j = k; j < len(s) && s[j] >= '0' && s[j] <= '9'; j++ {
+					}
+				}
+			}
+			out = append(out, s[i:j])
+			i = j
+		case unicode.IsLetter(rune(c)):
+			j := i
+			for j < len(s) && (unicode.IsLetter(rune(s[j])) || s[j] >= '0' && s[j] <= '9') {
+				j++
+			}
+			out = append(out, s[i:j])
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected %q", c)
+		}
+	}
+	return out, nil
+}
+
+func isHex(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/cjdelisle/goposit"
+	"github.com/cjdelisle/goposit/internal/positfmt"
+)
+
+// format is a posit size, the standard names use the same es as the Posit8..64 types
+type format struct {
+	nbits uint
+	es    uint
+}
+
+var standard = map[uint]uint{8: 0, 16: 1, 32: 2, 64: 3}
+
+// maxBits keeps the calculator responsive, every operation is done with big.Float
+const maxBits = 1 << 12
+
+// maxEs keeps the scale of every posit, at most nbits<<es, within the exponent of a big.Float
+const maxEs = 14
+
+// parseFormat accepts posit16, p16, posit12es1, p12es1 or 12,1
+func parseFormat(s string) (format, error) {
+	var f format
+	t := strings.ToLower(strings.TrimSpace(s))
+	switch {
+	case strings.Contains(t, ","):
+		if _, err := fmt.Sscanf(t, "%d,%d", &f.nbits, &f.es); err != nil {
+			return f, fmt.Errorf("invalid format %q", s)
+		}
+	case strings.HasPrefix(t, "posit") || strings.HasPrefix(t, "p"):
+		t = strings.TrimPrefix(strings.TrimPrefix(t, "posit"), "p")
+		if n, _ := fmt.Sscanf(t, "%des%d", &f.nbits, &f.es); n == 0 {
+			return f, fmt.Errorf("invalid format %q", s)
+		} else if n == 1 {
+			es, ok := standard[f.nbits]
+			if !ok || strings.Contains(t, "es") {
+				return f, fmt.Errorf("%q is not a standard size, give es as in posit%des1", s, f.nbits)
+			}
+			f.es = es
+		}
+	default:
+		return f, fmt.Errorf("invalid format %q, expected for example posit16 or 12,1", s)
+	}
+	if f.nbits <= f.es+3 {
+		return f, fmt.Errorf("invalid format %q, nbits must be more than es + 3", s)
+	}
+	if f.nbits > maxBits {
+		return f, fmt.Errorf("invalid format %q, nbits can be at most %d", s, maxBits)
+	}
+	if f.es > maxEs {
+		return f, fmt.Errorf("invalid format %q, es can be at most %d", s, maxEs)
+	}
+	return f, nil
+}
+
+func (f format) String() string {
+	if es, ok := standard[f.nbits]; ok && es == f.es {
+		return fmt.Sprintf("posit%d", f.nbits)
+	}
+	return fmt.Sprintf("posit%des%d", f.nbits, f.es)
+}
+
+func (f format) new() *goposit.SlowPosit { return goposit.NewSlowPosit(f.nbits, f.es) }
+
+// fromFloat rounds a value to the format
+func (f format) fromFloat(x *big.Float) *goposit.SlowPosit {
+	p := f.new()
+	p.FromFloat(x, false)
+	return p
+}
+
+// fromBits creates a posit from hex bits with or without 0x
+func (f format) fromBits(s string) (*goposit.SlowPosit, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	bits, ok := new(big.Int).SetString(strings.ReplaceAll(s, "_", ""), 16)
+	if !ok || bits.Sign() < 0 || bits.BitLen() > int(f.nbits) {
+		return nil, fmt.Errorf("%q is not a %d bit hex value", s, f.nbits)
+	}
+	p := f.new()
+	p.SetBits(bits)
+	return p, nil
+}
+
+// shortest is the shortest decimal which rounds back to the same posit
+func shortest(p *goposit.SlowPosit) string {
+	text, _ := p.MarshalText()
+	_, value, _ := strings.Cut(string(text), ":")
+	return value
+}
+
+// describe is the one line summary of a value: bits, shortest decimal and exact value
+func describe(p *goposit.SlowPosit) string {
+	out := positfmt.HexBits(p) + "  " + shortest(p)
+	if e := positfmt.Exact(p); e != shortest(p) {
+		out += "  (exactly " + e + ")"
+	}
+	return out
+}
+
+// binary returns the low n bits of x as a string of 0 and 1
+func binary(x *big.Int, n int) string {
+	var sb strings.Builder
+	for i := n - 1; i >= 0; i-- {
+		sb.WriteByte('0' + byte(x.Bit(i)))
+	}
+	return sb.String()
+}
+
+// inspect writes the bit fields of the posit
+func inspect(w io.Writer, f format, p *goposit.SlowPosit) {
+	n := int(f.nbits)
+	fmt.Fprintf(w, "%v %v (%d bits, es %d)\n", f, positfmt.HexBits(p), n, f.es)
+	fields := p.Decode()
+	raw := binary(p.Bits, n)
+	switch {
+	case fields.NaR:
+		fmt.Fprintf(w, "  bits      %v\n  value     NaR\n", raw)
+		return
+	case fields.Zero:
+		fmt.Fprintf(w, "  bits      %v\n  value     0\n", raw)
+		return
+	}
+
+	// negative posits are decoded from their two's complement, so split that into fields
+	mag := new(big.Int).Set(p.Bits)
+	if fields.Sign {
+		mag.Sub(new(big.Int).Lsh(big.NewInt(1), uint(n)), mag)
+	}
+	bits := binary(mag, n)
+	reg := bits[1 : 1+fields.RegimeLen]
+	exp := bits[1+fields.RegimeLen : 1+fields.RegimeLen+fields.ExponentLen]
+	frac := bits[1+fields.RegimeLen+fields.ExponentLen:]
+	split := strings.Join(nonEmpty(bits[:1], reg, exp, frac), " ")
+	if fields.Sign {
+		fmt.Fprintf(w, "  bits      %v\n  negated   %v\n  sign      -\n", raw, split)
+	} else {
+		fmt.Fprintf(w, "  bits      %v\n  sign      +\n", split)
+	}
+	fmt.Fprintf(w, "  regime    %v  k = %d\n", reg, fields.RegimeK)
+	if f.es > 0 {
+		if fields.ExponentLen < int(f.es) {
+			fmt.Fprintf(w, "  exponent  %v  = %d (%d of %d bits, the rest are 0)\n", orNone(exp),
+				fields.ExponentBits, fields.ExponentLen, f.es)
+		} else {
+			fmt.Fprintf(w, "  exponent  %v  = %d\n", exp, fields.ExponentBits)
+		}
+	}
+	if fields.FractionBits > 0 {
+		fmt.Fprintf(w, "  fraction  %v  = %v/2^%d\n", frac, fields.Fraction, fields.FractionBits)
+	} else {
+		fmt.Fprintf(w, "  fraction  none\n")
+	}
+	fmt.Fprintf(w, "  scale     2^%d\n", fields.Scale)
+	fmt.Fprintf(w, "  value     %v\n", positfmt.Exact(p))
+	if s := shortest(p); s != positfmt.Exact(p) {
+		fmt.Fprintf(w, "  shortest  %v\n", s)
+	}
+}
+
+func nonEmpty(s ...string) []string {
+	var out []string
+	for _, x := range s {
+		if x != "" {
+			out = append(out, x)
+		}
+	}
+	return out
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
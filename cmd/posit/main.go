@@ -0,0 +1,211 @@
+// Command posit is a calculator and bit inspector for posits of any size.
+//
+//	posit [-f format] EXPRESSION             evaluate in posit arithmetic
+//	posit [-f format] decode BITS...         show the fields of bit patterns
+//	posit [-f format] encode NUMBER...       round numbers and show their fields
+//	posit [-f format] convert FORMAT VALUE...  round values into another format
+//	posit [-f format] [repl]                 interactive mode
+//
+// A format is posit8, posit16, posit32 or posit64 with the es of the matching type, or
+// any size such as posit12es1 or 12,1. Expressions use + - * / and brackets, numbers are
+// decimals or hex bit patterns such as 0x4a3f, and the result of every operation is
+// rounded to the format. The names pi, e, ln2, sqrt2, nar, maxpos, minpos and ans (the
+// previous result) are known, as are the functions sqrt, abs, next, prev and ulp.
+//
+// For example posit -f posit8 1/3 prints the bits and value that 1/3 rounds to. An
+// expression which starts with a minus sign such as -1/3 is not taken to be a flag, -- may
+// also be put before it as usual.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+const help = `In the REPL, lines are evaluated as expressions or are one of these commands:
+  :format FORMAT       change the format, for example :format posit8 or :format 12,1
+  :decode BITS...      show the fields of bit patterns
+  :encode EXPR         evaluate and show the fields of the result
+  :convert FORMAT EXPR evaluate and round the result into another format
+  :help                show this
+  :quit                exit
+`
+
+func main() {
+	fs := flag.String("f", "posit16", "format: posit8, posit16, posit32, posit64, posit<n>es<e> or <n>,<e>")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: posit [-f format] [EXPRESSION | decode BITS... | "+
+			"encode NUMBER... | convert FORMAT VALUE... | repl]\n"+
+			"an expression may start with a minus sign, as in posit -f posit8 -1/3, or follow --\n")
+		flag.PrintDefaults()
+	}
+	flag.CommandLine.Parse(stopAtNumber(os.Args[1:]))
+	f, err := parseFormat(*fs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "posit:", err)
+		os.Exit(2)
+	}
+	c := &calc{ev: evaluator{f: f}, out: os.Stdout}
+	args := flag.Args()
+	if len(args) == 0 || (len(args) == 1 && args[0] == "repl") {
+		c.repl(os.Stdin)
+		return
+	}
+	switch args[0] {
+	case "decode", "encode", "convert":
+		rest := args[1:]
+		if len(rest) > 0 && rest[0] == "--" {
+			rest = rest[1:]
+		}
+		err = c.command(args[0], rest)
+	default:
+		err = c.print(strings.Join(args, " "))
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "posit:", err)
+		os.Exit(1)
+	}
+}
+
+// stopAtNumber puts -- before the first argument which is a negative number or expression so
+// that the flag package does not take posit -f posit8 -1/3 to be an unknown flag
+func stopAtNumber(args []string) []string {
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		switch {
+		case a == "--" || !strings.HasPrefix(a, "-"):
+			return args
+		case len(a) > 1 && strings.ContainsRune("0123456789.(", rune(a[1])):
+			return append(append(args[:i:i], "--"), args[i:]...)
+		case a == "-f" || a == "--f":
+			i++ // the format
+		}
+	}
+	return args
+}
+
+type calc struct {
+	ev  evaluator
+	out io.Writer
+}
+
+// print evaluates an expression and prints the result on one line
+func (c *calc) print(expr string) error {
+	p, err := c.ev.eval(expr)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(c.out, describe(p))
+	return nil
+}
+
+func (c *calc) command(name string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%v needs an argument", name)
+	}
+	switch name {
+	case "decode":
+		for _, a := range args {
+			p, err := c.ev.f.fromBits(a)
+			if err != nil {
+				return err
+			}
+			inspect(c.out, c.ev.f, p)
+		}
+	case "encode":
+		for _, a := range args {
+			p, err := c.ev.eval(a)
+			if err != nil {
+				return err
+			}
+			inspect(c.out, c.ev.f, p)
+		}
+	case "convert":
+		to, err := parseFormat(args[0])
+		if err != nil {
+			return err
+		}
+		if len(args) == 1 {
+			return fmt.Errorf("convert needs a value")
+		}
+		for _, a := range args[1:] {
+			p, err := c.ev.eval(a)
+			if err != nil {
+				return err
+			}
+			// the value of a posit is exact as a big.Float so it is rounded only once
+			q := to.new()
+			if p.IsNaR() {
+				q.NaR()
+			} else {
+				q = to.fromFloat(p.ToFloat())
+			}
+			fmt.Fprintf(c.out, "%v %v\n%v %v\n", c.ev.f, describe(p), to, describe(q))
+		}
+	}
+	return nil
+}
+
+// repl reads lines until the input ends or :quit, errors are printed and do not stop it
+func (c *calc) repl(in io.Reader) {
+	s := bufio.NewScanner(in)
+	for {
+		fmt.Fprintf(c.out, "%v> ", c.ev.f)
+		if !s.Scan() {
+			fmt.Fprintln(c.out)
+			return
+		}
+		line := strings.TrimSpace(s.Text())
+		if line == "" {
+			continue
+		}
+		if err := c.line(line); err == io.EOF {
+			return
+		} else if err != nil {
+			fmt.Fprintln(c.out, "error:", err)
+		}
+	}
+}
+
+// line handles one line of the REPL, io.EOF means quit
+func (c *calc) line(line string) error {
+	if !strings.HasPrefix(line, ":") {
+		return c.print(line)
+	}
+	fields := strings.Fields(line[1:])
+	if len(fields) == 0 {
+		return fmt.Errorf("missing command, try :help")
+	}
+	args := fields[1:]
+	switch fields[0] {
+	case "q", "quit", "exit":
+		return io.EOF
+	case "h", "help":
+		io.WriteString(c.out, help)
+	case "f", "format":
+		if len(args) != 1 {
+			return fmt.Errorf("expected :format FORMAT")
+		}
+		f, err := parseFormat(args[0])
+		if err != nil {
+			return err
+		}
+		c.ev.f = f
+	case "d", "decode":
+		return c.command("decode", args)
+	case "e", "encode":
+		return c.command("encode", []string{strings.Join(args, " ")})
+	case "c", "convert":
+		if len(args) < 2 {
+			return fmt.Errorf("expected :convert FORMAT EXPR")
+		}
+		return c.command("convert", []string{args[0], strings.Join(args[1:], " ")})
+	default:
+		return fmt.Errorf("unknown command %q, try :help", fields[0])
+	}
+	return nil
+}
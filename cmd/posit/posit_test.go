@@ -0,0 +1,122 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/cjdelisle/goposit/internal/positfmt"
+)
+
+func TestParseFormat(t *testing.T) {
+	for in, want := range map[string]format{
+		"posit8": {8, 0}, "P16": {16, 1}, "posit32": {32, 2}, "p64": {64, 3},
+		"posit12es1": {12, 1}, "p16es2": {16, 2}, "6,1": {6, 1},
+	} {
+		if f, err := parseFormat(in); err != nil || f != want {
+			t.Errorf("%v: got %v %v", in, f, err)
+		}
+	}
+	for _, in := range []string{"posit12", "float32", "4,1", "p", "8,x"} {
+		if _, err := parseFormat(in); err == nil {
+			t.Errorf("%v should not be a valid format", in)
+		}
+	}
+	if _, err := parseFormat("5000,1"); err == nil || !strings.Contains(err.Error(), "at most 4096") {
+		t.Errorf("5000,1 should be too large, got %v", err)
+	}
+	for _, in := range []string{"100,70", "posit100es70", "64,15"} {
+		if _, err := parseFormat(in); err == nil || !strings.Contains(err.Error(), "es can be at most 14") {
+			t.Errorf("%v should have too large an es, got %v", in, err)
+		}
+	}
+}
+
+func TestStopAtNumber(t *testing.T) {
+	for _, c := range []struct{ in, want string }{
+		{"-f posit8 -1/3", "-f posit8 -- -1/3"},
+		{"-f=posit8 -.5", "-f=posit8 -- -.5"},
+		{"-(1+2)", "-- -(1+2)"},
+		{"-f posit8 1/3", "-f posit8 1/3"},
+		{"-f posit8 -- -1", "-f posit8 -- -1"},
+		{"decode -- -1", "decode -- -1"},
+		{"-x", "-x"},
+	} {
+		if got := strings.Join(stopAtNumber(strings.Fields(c.in)), " "); got != c.want {
+			t.Errorf("%q: got %q expected %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestEval(t *testing.T) {
+	p16, _ := parseFormat("posit16")
+	p8, _ := parseFormat("posit8")
+	for _, c := range []struct {
+		f    format
+		expr string
+		bits string
+	}{
+		{p16, "1+1", "0x5000"},
+		{p16, "-(2 * 3) / 4", "0xb800"},
+		{p16, "1/3", "0x2555"},
+		{p16, "0x4000 + 0x4000", "0x5000"},
+		{p16, "1/0", "0x8000"},
+		{p16, "sqrt(0.25)", "0x3000"},
+		{p16, "abs(-1.5e0)", "0x4800"},
+		{p16, "next(1) - 1", "0x0100"},
+		{p16, "pi", "0x5922"},
+		{p8, "1/3", "0x15"},
+		{p8, "maxpos * 2", "0x7f"},
+		{p8, "-minpos", "0xff"},
+		// each operation rounds, 64 + 1 is back to 64 in Posit8
+		{p8, "64 + 1 - 64", "0x00"},
+	} {
+		e := evaluator{f: c.f}
+		p, err := e.eval(c.expr)
+		if err != nil {
+			t.Errorf("%v: %v", c.expr, err)
+		} else if positfmt.HexBits(p) != c.bits {
+			t.Errorf("%v %v: got %v expected %v", c.f, c.expr, describe(p), c.bits)
+		}
+	}
+	e := evaluator{f: p16}
+	for _, bad := range []string{"", "1 +", "(1", "foo", "sqrt 2", "1 2", "0x10000", "3 % 2", "ans"} {
+		if _, err := e.eval(bad); err == nil {
+			t.Errorf("%q should not evaluate", bad)
+		}
+	}
+}
+
+func TestInspect(t *testing.T) {
+	f, _ := parseFormat("posit16")
+	p, _ := f.fromBits("b5c1")
+	var buf bytes.Buffer
+	inspect(&buf, f, p)
+	for _, want := range []string{"negated   0 10 0 101000111111", "k = 0", "value     -1.640380859375"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("expected %q in\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestREPL(t *testing.T) {
+	f, _ := parseFormat("posit16")
+	var out bytes.Buffer
+	c := &calc{ev: evaluator{f: f}, out: &out}
+	c.repl(strings.NewReader("1/3\nans * 3\n:format posit8\nans\n:convert 12,1 pi\nbad\n:quit\n1\n"))
+	got := out.String()
+	for _, want := range []string{
+		"posit16> 0x2555  0.3333",
+		"posit16> 0x4000  1\n",
+		"posit8> 0x40  1\n",
+		"posit12es1 0x590  3.125",
+		"error: unknown name",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in\n%s", want, got)
+		}
+	}
+	if strings.Count(got, "posit8> ") != 4 {
+		t.Errorf("the REPL should stop at :quit\n%s", got)
+	}
+}
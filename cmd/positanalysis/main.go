@@ -0,0 +1,236 @@
+// Command positanalysis writes CSV describing the accuracy of a posit configuration and
+// compares it to other posit configurations and to IEEE style floats.
+//
+//	positanalysis -n 16 -es 1 -report range -compare 16,2,bfloat16,float16
+//	positanalysis -n 16 -es 1 -report accuracy -compare 16,2,bfloat16 > accuracy.csv
+//	positanalysis -n 16 -es 2 -report golden -compare bfloat16
+//	positanalysis -n 16 -es 1 -report errors -op mul -samples 10000 -min -8 -max 8
+//	positanalysis -n 16 -es 1 -report histogram -op add
+//
+// The range and accuracy reports have one column group per format. The golden report
+// has one row per float in -compare giving the binades where the posit has at least as
+// many fraction bits. The errors and histogram reports sample the rounding error of an
+// operation on the posit.
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cjdelisle/goposit/analysis"
+)
+
+type options struct {
+	nbits    uint
+	es       uint
+	report   string
+	compare  string
+	op       string
+	samples  int
+	seed     int64
+	minScale int
+	maxScale int
+}
+
+func main() {
+	var o options
+	flag.UintVar(&o.nbits, "n", 16, "number of bits")
+	flag.UintVar(&o.es, "es", 1, "number of exponent bits")
+	flag.StringVar(&o.report, "report", "accuracy", "range, accuracy, golden, errors or histogram")
+	flag.StringVar(&o.compare, "compare", "", "comma separated formats to compare with, "+
+		"float16, bfloat16, float32, float64 or a posit as nbits,es")
+	flag.StringVar(&o.op, "op", "add", "operation for errors and histogram: add, sub, mul or div")
+	flag.IntVar(&o.samples, "samples", 10000, "number of sampled operations")
+	flag.Int64Var(&o.seed, "seed", 1, "random seed for sampling")
+	flag.IntVar(&o.minScale, "min", -8, "smallest binade of sampled operands")
+	flag.IntVar(&o.maxScale, "max", 8, "largest binade of sampled operands")
+	out := flag.String("o", "", "output file, default standard output")
+	flag.Parse()
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			fail(err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := run(w, o); err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "positanalysis:", err)
+	os.Exit(1)
+}
+
+// format is either a posit configuration or a float
+type format struct {
+	name      string
+	nbits, es uint
+	float     *analysis.FloatFormat
+}
+
+func (f format) dynamicRange() analysis.Range {
+	if f.float != nil {
+		return f.float.DynamicRange()
+	}
+	return analysis.DynamicRange(f.nbits, f.es)
+}
+
+func (f format) decimalAccuracy() []analysis.Accuracy {
+	if f.float != nil {
+		return f.float.DecimalAccuracy()
+	}
+	return analysis.DecimalAccuracy(f.nbits, f.es)
+}
+
+func positFormat(nbits, es uint) format {
+	return format{name: fmt.Sprintf("posit%des%d", nbits, es), nbits: nbits, es: es}
+}
+
+func checkPosit(nbits, es uint) error {
+	if nbits <= es+3 || nbits > 64 {
+		return fmt.Errorf("posit%des%d: nbits must be more than es + 3 and at most 64", nbits, es)
+	}
+	return nil
+}
+
+// parseCompare parses the -compare list, a posit is two numbers so "16,2,bfloat16" is
+// a 16 bit es 2 posit and bfloat16.
+func parseCompare(s string) ([]format, error) {
+	var out []format
+	fields := strings.Split(s, ",")
+	for i := 0; i < len(fields); i++ {
+		name := strings.ToLower(strings.TrimSpace(fields[i]))
+		if name == "" {
+			continue
+		}
+		if n, err := strconv.ParseUint(name, 10, 8); err == nil {
+			if i+1 == len(fields) {
+				return nil, fmt.Errorf("posit %q has no es", name)
+			}
+			i++
+			es, err := strconv.ParseUint(strings.TrimSpace(fields[i]), 10, 8)
+			if err != nil {
+				return nil, fmt.Errorf("invalid es %q", fields[i])
+			}
+			if err := checkPosit(uint(n), uint(es)); err != nil {
+				return nil, err
+			}
+			out = append(out, positFormat(uint(n), uint(es)))
+			continue
+		}
+		found := false
+		for j, f := range analysis.FloatFormats {
+			if f.Name == name {
+				out = append(out, format{name: f.Name, float: &analysis.FloatFormats[j]})
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown format %q", name)
+		}
+	}
+	return out, nil
+}
+
+func run(w io.Writer, o options) error {
+	if err := checkPosit(o.nbits, o.es); err != nil {
+		return err
+	}
+	others, err := parseCompare(o.compare)
+	if err != nil {
+		return err
+	}
+	formats := append([]format{positFormat(o.nbits, o.es)}, others...)
+
+	switch o.report {
+	case "range":
+		return writeRanges(w, formats)
+	case "accuracy":
+		names := make([]string, len(formats))
+		acc := make([][]analysis.Accuracy, len(formats))
+		for i, f := range formats {
+			names[i], acc[i] = f.name, f.decimalAccuracy()
+		}
+		return analysis.WriteAccuracyCSV(w, names, acc...)
+	case "golden":
+		return writeGolden(w, formats[0], others)
+	case "errors", "histogram":
+		op := analysis.Op(o.op)
+		switch op {
+		case analysis.Add, analysis.Sub, analysis.Mul, analysis.Div:
+		default:
+			return fmt.Errorf("unknown operation %q", o.op)
+		}
+		if o.maxScale < o.minScale {
+			return fmt.Errorf("-max must not be less than -min")
+		}
+		if len(others) > 0 {
+			return fmt.Errorf("the %v report does not compare formats", o.report)
+		}
+		r := analysis.OpErrors(o.nbits, o.es, op, o.samples, o.minScale, o.maxScale,
+			rand.New(rand.NewSource(o.seed)))
+		if o.report == "histogram" {
+			return analysis.WriteHistogramCSV(w, r)
+		}
+		return analysis.WriteSamplesCSV(w, r)
+	}
+	return fmt.Errorf("unknown report %q", o.report)
+}
+
+// writeRanges writes one row per format, a single format uses the name,value layout of
+// analysis.WriteRangeCSV
+func writeRanges(w io.Writer, formats []format) error {
+	if len(formats) == 1 {
+		return analysis.WriteRangeCSV(w, formats[0].dynamicRange())
+	}
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"format", "minpos", "maxpos", "min_scale", "max_scale", "decades"})
+	for _, f := range formats {
+		r := f.dynamicRange()
+		cw.Write([]string{f.name, r.MinPos.Text('g', 10), r.MaxPos.Text('g', 10), strconv.Itoa(r.MinScale),
+			strconv.Itoa(r.MaxScale), strconv.FormatFloat(r.Decades, 'g', 6, 64)})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeGolden writes the golden zone of the posit against each float, by default against
+// every predefined float
+func writeGolden(w io.Writer, posit format, others []format) error {
+	var floats []analysis.FloatFormat
+	for _, f := range others {
+		if f.float == nil {
+			return fmt.Errorf("the golden report compares with floats, not %v", f.name)
+		}
+		floats = append(floats, *f.float)
+	}
+	if len(floats) == 0 {
+		floats = analysis.FloatFormats
+	}
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"posit", "float", "min_scale", "max_scale", "decades"})
+	for _, f := range floats {
+		lo, hi, ok := analysis.GoldenZone(posit.nbits, posit.es, f)
+		if !ok {
+			cw.Write([]string{posit.name, f.Name, "", "", "0"})
+			continue
+		}
+		decades := float64(hi+1-lo) * math.Log10(2)
+		cw.Write([]string{posit.name, f.Name, strconv.Itoa(lo), strconv.Itoa(hi),
+			strconv.FormatFloat(decades, 'g', 6, 64)})
+	}
+	cw.Flush()
+	return cw.Error()
+}
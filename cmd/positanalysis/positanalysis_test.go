@@ -0,0 +1,53 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReports(t *testing.T) {
+	for _, c := range []struct {
+		o    options
+		want []string
+	}{
+		{options{nbits: 16, es: 1, report: "range", compare: "16,2, bfloat16"},
+			[]string{"format,minpos,maxpos,", "posit16es1,3.725290298e-09,268435456,-28,28,16.8", "posit16es2,", "bfloat16,"}},
+		{options{nbits: 16, es: 1, report: "range"}, []string{"name,value\n", "max_scale,28\n"}},
+		{options{nbits: 8, es: 0, report: "accuracy", compare: "float16"},
+			[]string{"scale,magnitude,posit8es0_fraction_bits,", "float16_best_digits\n", "\n0,2^0,5,", "\n15,2^15,,,,10,"}},
+		{options{nbits: 16, es: 1, report: "golden", compare: "float16"},
+			[]string{"posit,float,", "posit16es1,float16,-6,5,3.6"}},
+		{options{nbits: 16, es: 1, report: "histogram", op: "mul", samples: 100, minScale: -2, maxScale: 2, seed: 1},
+			[]string{"digits,count\nexact,"}},
+		{options{nbits: 8, es: 0, report: "errors", op: "div", samples: 10, seed: 1},
+			[]string{"x,y,exact,result,rel_err,digits\n"}},
+	} {
+		var buf bytes.Buffer
+		if err := run(&buf, c.o); err != nil {
+			t.Errorf("%+v: %v", c.o, err)
+			continue
+		}
+		for _, w := range c.want {
+			if !strings.Contains(buf.String(), w) {
+				t.Errorf("%+v: output does not contain %q:\n%s", c.o, w, buf.String())
+			}
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	for _, o := range []options{
+		{nbits: 4, es: 1, report: "range"},
+		{nbits: 16, es: 1, report: "range", compare: "16"},
+		{nbits: 16, es: 1, report: "range", compare: "float8"},
+		{nbits: 16, es: 1, report: "golden", compare: "16,2"},
+		{nbits: 16, es: 1, report: "errors", op: "pow"},
+		{nbits: 16, es: 1, report: "errors", op: "add", minScale: 2, maxScale: 1},
+		{nbits: 16, es: 1, report: "plot"},
+	} {
+		if err := run(new(bytes.Buffer), o); err == nil {
+			t.Errorf("%+v: expected an error", o)
+		}
+	}
+}
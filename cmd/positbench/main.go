@@ -0,0 +1,69 @@
+// Command positbench runs the benchmarks of the bench package and prints them as a table
+// with one row per operation and one column per type, for example
+//
+//	positbench -run 'float64|Posit16|Slow16es2' -baseline float64
+//
+// Each cell is the time per value, the allocations per call and how many times slower
+// than the baseline the operation is.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+	"github.com/cjdelisle/goposit/bench"
+)
+
+func main() {
+	testing.Init()
+	run := flag.String("run", ".", "only run benchmarks whose Type/Op matches this regular expression")
+	baseline := flag.String("baseline", "float64", "type to compare the others to, empty for none")
+	markdown := flag.Bool("md", false, "write a Markdown table")
+	benchtime := flag.String("benchtime", "200ms", "time to run each benchmark, or a count such as 1000x")
+	slow := flag.String("slow", "", "extra SlowPosit sizes as nbits,es separated by spaces, such as \"10,1 80,3\"")
+	tables := flag.Int("tables", 0, "bytes of lookup tables SlowPosit may build, see SetTableBudget")
+	quiet := flag.Bool("q", false, "do not print progress")
+	flag.Parse()
+
+	if err := flag.Set("test.benchtime", *benchtime); err != nil {
+		fail("invalid -benchtime: %v", err)
+	}
+	re, err := regexp.Compile(*run)
+	if err != nil {
+		fail("invalid -run: %v", err)
+	}
+	goposit.SetTableBudget(*tables)
+
+	cases := bench.Cases()
+	for _, s := range strings.Fields(*slow) {
+		var nbits, es uint
+		if _, err := fmt.Sscanf(s, "%d,%d", &nbits, &es); err != nil || nbits <= es+3 {
+			fail("invalid SlowPosit size %q, expected nbits,es", s)
+		}
+		cases = append(cases, bench.SlowCases(nbits, es)...)
+	}
+	cases = bench.Match(cases, re)
+	if len(cases) == 0 {
+		fail("no benchmarks match %q", *run)
+	}
+
+	results := bench.Run(cases, func(r bench.Result) {
+		if !*quiet {
+			fmt.Fprintf(os.Stderr, "%-24s %12.1f ns/op %6d allocs/op\n", r.Name(), r.NsPerOp, r.AllocsPerOp)
+		}
+	})
+	opt := bench.TableOptions{Baseline: *baseline, Markdown: *markdown}
+	if err := bench.WriteTable(os.Stdout, results, opt); err != nil {
+		fail("%v", err)
+	}
+}
+
+func fail(format string, a ...any) {
+	fmt.Fprintf(os.Stderr, "positbench: "+format+"\n", a...)
+	os.Exit(2)
+}
@@ -0,0 +1,271 @@
+// Command posittables writes complete tables for a small posit size: every value with its
+// decoded fields, or the result of an operation for every pair of inputs.
+//
+//	posittables -n 8 -es 0 -table values -format md
+//	posittables -n 6 -es 1 -table mul -format csv -decimal
+//	posittables -n 8 -es 0 -table add -format go -package fast > add_table.go
+//
+// Tables are computed with SlowPosit. Operation tables are indexed by the bits of the two
+// operands, row x and column y hold x op y, so the Go source output can be used directly
+// as a lookup table by a fast implementation.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/cjdelisle/goposit"
+	"github.com/cjdelisle/goposit/internal/positfmt"
+)
+
+type options struct {
+	nbits   uint
+	es      uint
+	table   string
+	format  string
+	decimal bool
+	pkg     string
+	name    string
+}
+
+// maximum sizes, the tables have 2**n or 4**n entries
+const maxValueBits = 20
+const maxOpBits = 12
+
+var ops = map[string]func(x, y *goposit.SlowPosit) *goposit.SlowPosit{
+	"add": (*goposit.SlowPosit).Add,
+	"sub": (*goposit.SlowPosit).Sub,
+	"mul": (*goposit.SlowPosit).Mul,
+	"div": (*goposit.SlowPosit).Div,
+}
+
+func main() {
+	var o options
+	flag.UintVar(&o.nbits, "n", 8, "number of bits")
+	flag.UintVar(&o.es, "es", 0, "number of exponent bits")
+	flag.StringVar(&o.table, "table", "values", "values, add, sub, mul, div or sqrt")
+	flag.StringVar(&o.format, "format", "csv", "csv, md or go")
+	flag.BoolVar(&o.decimal, "decimal", false, "show operands and results as exact decimals rather than bits (csv and md)")
+	flag.StringVar(&o.pkg, "package", "main", "package name for -format go")
+	flag.StringVar(&o.name, "name", "", "variable name for -format go, default for example Posit8es0Add")
+	out := flag.String("o", "", "output file, default standard output")
+	flag.Parse()
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			fail(err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := run(w, o); err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "posittables:", err)
+	os.Exit(1)
+}
+
+func run(w io.Writer, o options) error {
+	if o.nbits <= o.es+3 {
+		return fmt.Errorf("nbits must be more than es + 3")
+	}
+	max := uint(maxValueBits)
+	if o.table != "values" && o.table != "sqrt" {
+		if _, ok := ops[o.table]; !ok {
+			return fmt.Errorf("unknown table %q", o.table)
+		}
+		max = maxOpBits
+	}
+	if o.nbits > max {
+		return fmt.Errorf("the %v table is limited to %d bits", o.table, max)
+	}
+	if o.decimal && o.format == "go" {
+		return fmt.Errorf("-decimal only applies to csv and md, the go format is always bits")
+	}
+	if o.name == "" {
+		o.name = fmt.Sprintf("Posit%des%d%s%s", o.nbits, o.es, strings.ToUpper(o.table[:1]), o.table[1:])
+	}
+	switch o.format {
+	case "csv", "md":
+		bw := bufio.NewWriter(w)
+		if o.table == "values" {
+			writeValues(bw, o)
+		} else {
+			writeOp(bw, o)
+		}
+		return bw.Flush()
+	case "go":
+		var buf bytes.Buffer
+		if err := writeGo(&buf, o); err != nil {
+			return err
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(src)
+		return err
+	}
+	return fmt.Errorf("unknown format %q", o.format)
+}
+
+// posits returns every posit of the size in order of their bits
+func posits(o options) []*goposit.SlowPosit {
+	out := make([]*goposit.SlowPosit, 1<<o.nbits)
+	for i := range out {
+		out[i] = goposit.NewSlowPosit(o.nbits, o.es)
+		out[i].SetBits(big.NewInt(int64(i)))
+	}
+	return out
+}
+
+// row writes one line of a CSV or Markdown table
+func row(w io.Writer, md bool, cols ...string) {
+	if md {
+		fmt.Fprintf(w, "| %s |\n", strings.Join(cols, " | "))
+	} else {
+		fmt.Fprintln(w, strings.Join(cols, ","))
+	}
+}
+
+func header(w io.Writer, md bool, cols ...string) {
+	row(w, md, cols...)
+	if md {
+		dashes := make([]string, len(cols))
+		for i := range dashes {
+			dashes[i] = "---"
+		}
+		row(w, md, dashes...)
+	}
+}
+
+// writeValues writes one row per posit with its decoded fields, negative posits are
+// decoded from their two's complement so the fields describe the magnitude.
+func writeValues(w io.Writer, o options) {
+	md := o.format == "md"
+	header(w, md, "bits", "sign", "regime_k", "regime_len", "exponent", "exponent_len", "fraction",
+		"fraction_bits", "scale", "value")
+	for _, p := range posits(o) {
+		f := p.Decode()
+		switch {
+		case f.NaR:
+			row(w, md, positfmt.HexBits(p), "", "", "", "", "", "", "", "", "NaR")
+		case f.Zero:
+			row(w, md, positfmt.HexBits(p), "", "", "", "", "", "", "", "", "0")
+		default:
+			sign := "+"
+			if f.Sign {
+				sign = "-"
+			}
+			row(w, md, positfmt.HexBits(p), sign, fmt.Sprint(f.RegimeK), fmt.Sprint(f.RegimeLen), fmt.Sprint(f.ExponentBits),
+				fmt.Sprint(f.ExponentLen), f.Fraction.String(), fmt.Sprint(f.FractionBits), fmt.Sprint(f.Scale), positfmt.Exact(p))
+		}
+	}
+}
+
+// writeOp writes an operation table, sqrt is a single column
+func writeOp(w io.Writer, o options) {
+	md := o.format == "md"
+	all := posits(o)
+	label := positfmt.HexBits
+	if o.decimal {
+		label = positfmt.Exact
+	}
+	if o.table == "sqrt" {
+		header(w, md, "x", "sqrt")
+		for _, x := range all {
+			row(w, md, label(x), label(x.Sqrt()))
+		}
+		return
+	}
+	op := ops[o.table]
+	cols := []string{o.table}
+	for _, y := range all {
+		cols = append(cols, label(y))
+	}
+	header(w, md, cols...)
+	for _, x := range all {
+		cols = cols[:0]
+		cols = append(cols, label(x))
+		for _, y := range all {
+			cols = append(cols, label(op(x, y)))
+		}
+		row(w, md, cols...)
+	}
+}
+
+// goType is the smallest unsigned integer which holds the bits
+func goType(nbits uint) string {
+	for _, n := range []uint{8, 16, 32} {
+		if nbits <= n {
+			return fmt.Sprint("uint", n)
+		}
+	}
+	return "uint64"
+}
+
+// writeGo writes Go source for the table, values are float64s with NaR as NaN and
+// operation tables are the result bits indexed by the operand bits.
+func writeGo(w io.Writer, o options) error {
+	all := posits(o)
+	fmt.Fprintf(w, "// Code generated by posittables -n %d -es %d -table %s -format go; DO NOT EDIT.\n\n",
+		o.nbits, o.es, o.table)
+	fmt.Fprintf(w, "package %s\n\n", o.pkg)
+	switch o.table {
+	case "values":
+		fmt.Fprintf(w, "import \"math\"\n\n")
+		fmt.Fprintf(w, "// %s is the value of every %d bit es %d posit indexed by its bits, NaR is NaN\n",
+			o.name, o.nbits, o.es)
+		fmt.Fprintf(w, "var %s = [%d]float64{\n", o.name, len(all))
+		for _, p := range all {
+			if p.IsNaR() {
+				fmt.Fprintf(w, "math.NaN(), // %s\n", positfmt.HexBits(p))
+			} else {
+				f, acc := p.ToFloat().Float64()
+				if acc != big.Exact {
+					return fmt.Errorf("the value of %s is not exact as a float64", positfmt.HexBits(p))
+				}
+				fmt.Fprintf(w, "%v, // %s\n", f, positfmt.HexBits(p))
+			}
+		}
+	case "sqrt":
+		fmt.Fprintf(w, "// %s is the square root of every %d bit es %d posit, indexed by its bits\n",
+			o.name, o.nbits, o.es)
+		fmt.Fprintf(w, "var %s = [%d]%s{", o.name, len(all), goType(o.nbits))
+		for i, x := range all {
+			if i%16 == 0 {
+				fmt.Fprint(w, "\n")
+			}
+			fmt.Fprintf(w, "%s, ", positfmt.HexBits(x.Sqrt()))
+		}
+	default:
+		op := ops[o.table]
+		fmt.Fprintf(w, "// %s is x %s y for every pair of %d bit es %d posits, indexed [x][y] by their bits\n",
+			o.name, o.table, o.nbits, o.es)
+		fmt.Fprintf(w, "var %s = [%d][%d]%s{\n", o.name, len(all), len(all), goType(o.nbits))
+		for _, x := range all {
+			fmt.Fprint(w, "{")
+			for i, y := range all {
+				if i%16 == 0 {
+					fmt.Fprint(w, "\n")
+				}
+				fmt.Fprintf(w, "%s, ", positfmt.HexBits(op(x, y)))
+			}
+			fmt.Fprint(w, "\n},\n")
+		}
+	}
+	fmt.Fprint(w, "\n}\n")
+	return nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+func TestOpTable(t *testing.T) {
+	// the generated table must agree with Posit8 for every pair
+	var buf bytes.Buffer
+	if err := run(&buf, options{nbits: 8, es: 0, table: "add", format: "csv"}); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 257 {
+		t.Fatalf("expected a header and 256 rows, got %d lines", len(lines))
+	}
+	p := goposit.NewPosit8()
+	for x, line := range lines[1:] {
+		cols := strings.Split(line, ",")
+		for y, c := range cols[1:] {
+			bits, _ := strconv.ParseUint(c, 0, 8)
+			if want := p.SetBits(uint8(x)).Add(p.SetBits(uint8(y))).Bits(); uint8(bits) != want {
+				t.Fatalf("%02x + %02x: table has %v, Posit8 gives %02x", x, y, c, want)
+			}
+		}
+	}
+}
+
+func TestFormats(t *testing.T) {
+	for _, c := range []struct {
+		o    options
+		want []string
+	}{
+		{options{nbits: 5, es: 1, table: "values", format: "md"},
+			[]string{"| bits | sign |", "| 0x05 | + | -1 | 2 | 0 | 1 | 1 | 1 | -2 | 0.375 |", "| 0x10 |  |"}},
+		{options{nbits: 4, es: 0, table: "mul", format: "csv", decimal: true},
+			[]string{"mul,0,0.25,0.5,0.75,1,1.5,2,4,NaR,-4", "\n1.5,0,0.5,0.75,1,1.5,2,2,4,NaR,"}},
+		{options{nbits: 4, es: 0, table: "sqrt", format: "csv"}, []string{"x,sqrt\n0x0,0x0\n0x1,0x2\n"}},
+		{options{nbits: 6, es: 1, table: "div", format: "go", pkg: "fast"},
+			[]string{"DO NOT EDIT", "package fast", "var Posit6es1Div = [64][64]uint8{"}},
+		{options{nbits: 8, es: 0, table: "values", format: "go", pkg: "fast", name: "Values"},
+			[]string{"import \"math\"", "var Values = [256]float64{", "math.NaN(), // 0x80", "\t0.015625,   // 0x01\n"}},
+	} {
+		var buf bytes.Buffer
+		if err := run(&buf, c.o); err != nil {
+			t.Fatal(err)
+		}
+		for _, w := range c.want {
+			if !strings.Contains(buf.String(), w) {
+				t.Errorf("%+v: expected %q in\n%.2000s", c.o, w, buf.String())
+			}
+		}
+	}
+
+	for _, o := range []options{
+		{nbits: 4, es: 1, table: "values", format: "csv"},
+		{nbits: 13, es: 1, table: "add", format: "csv"},
+		{nbits: 8, es: 0, table: "pow", format: "csv"},
+		{nbits: 8, es: 0, table: "add", format: "xml"},
+		{nbits: 16, es: 9, table: "values", format: "go"},
+		{nbits: 6, es: 1, table: "add", format: "go", decimal: true},
+	} {
+		if err := run(&bytes.Buffer{}, o); err == nil {
+			t.Errorf("%+v should fail", o)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package goposit
+
+import (
+	"math/big"
+)
+
+// SlowComplex is a complex number made of two SlowPosits of the same size
+type SlowComplex struct {
+	Re *SlowPosit
+	Im *SlowPosit
+}
+
+// NewSlowComplex creates a complex number from a real and imaginary part
+func NewSlowComplex(re, im *SlowPosit) *SlowComplex {
+	assertCompat(re, im)
+	return &SlowComplex{Re: re, Im: im}
+}
+
+// NewSlowComplexPolar creates a complex number from an absolute value and a phase angle
+// in radians, each part is computed in high precision and rounded to nearest even.
+func NewSlowComplexPolar(r, theta *SlowPosit) *SlowComplex {
+	assertCompat(r, theta)
+	if r.IsNaR() || theta.IsNaR() {
+		return nanComplex(r)
+	}
+	prec := r.hiPrec()
+	sin, cos := BigSinCos(theta.ToFloat(), prec)
+	rf := r.ToFloat()
+	sin.Mul(sin, rf)
+	cos.Mul(cos, rf)
+	return &SlowComplex{Re: outPosit(r, cos), Im: outPosit(r, sin)}
+}
+
+// hiPrec is the precision used for intermediate results before they are rounded to a posit
+func (p *SlowPosit) hiPrec() uint { return 2*p.nbits + bigGuardBits }
+
+func nanComplex(template *SlowPosit) *SlowComplex {
+	return &SlowComplex{Re: template.Clone().NaR(), Im: template.Clone().NaR()}
+}
+
+func (z *SlowComplex) isNaR() bool { return z.Re.IsNaR() || z.Im.IsNaR() }
+
+// fusedSum adds up exact floats and rounds the result only once
+func fusedSum(template *SlowPosit, terms ...*big.Float) *SlowPosit {
+	sum := new(big.Float)
+	for _, t := range terms {
+		sum = exactAdd(sum, t)
+	}
+	return outPosit(template, sum)
+}
+
+// Add takes the sum of two complex numbers, each part rounds to nearest even
+func (z *SlowComplex) Add(x *SlowComplex) *SlowComplex {
+	return &SlowComplex{Re: z.Re.Add(x.Re), Im: z.Im.Add(x.Im)}
+}
+
+// Sub takes the difference of two complex numbers, each part rounds to nearest even
+func (z *SlowComplex) Sub(x *SlowComplex) *SlowComplex {
+	return &SlowComplex{Re: z.Re.Sub(x.Re), Im: z.Im.Sub(x.Im)}
+}
+
+// Mul takes the product of two complex numbers, the partial products are made with
+// MulPromote so they are exact and each part of the result is rounded only once.
+func (z *SlowComplex) Mul(x *SlowComplex) *SlowComplex {
+	if z.isNaR() || x.isNaR() {
+		return nanComplex(z.Re)
+	}
+	rr := z.Re.MulPromote(x.Re).ToFloat()
+	ii := z.Im.MulPromote(x.Im).ToFloat()
+	ri := z.Re.MulPromote(x.Im).ToFloat()
+	ir := z.Im.MulPromote(x.Re).ToFloat()
+	return &SlowComplex{
+		Re: fusedSum(z.Re, rr, ii.Neg(ii)),
+		Im: fusedSum(z.Re, ri, ir),
+	}
+}
+
+// Div takes the quotent of two complex numbers, each part of the result is rounded only
+// once. Division by zero results in NaR.
+func (z *SlowComplex) Div(x *SlowComplex) *SlowComplex {
+	if z.isNaR() || x.isNaR() || (x.Re.isZero() && x.Im.isZero()) {
+		return nanComplex(z.Re)
+	}
+	a, b := z.Re.ToFloat(), z.Im.ToFloat()
+	c, d := x.Re.ToFloat(), x.Im.ToFloat()
+	ac := new(big.Float).SetPrec(a.Prec()+c.Prec()).Mul(a, c)
+	bd := new(big.Float).SetPrec(b.Prec()+d.Prec()).Mul(b, d)
+	bc := new(big.Float).SetPrec(b.Prec()+c.Prec()).Mul(b, c)
+	ad := new(big.Float).SetPrec(a.Prec()+d.Prec()).Mul(a, d)
+	cc := new(big.Float).SetPrec(2*c.Prec()).Mul(c, c)
+	dd := new(big.Float).SetPrec(2*d.Prec()).Mul(d, d)
+	den := exactAdd(cc, dd)
+	re := exactAdd(ac, bd)
+	im := exactAdd(bc, ad.Neg(ad))
+	prec := z.Re.hiPrec()
+	re = quoOdd(re, den, prec)
+	im = quoOdd(im, den, prec)
+	return &SlowComplex{Re: outPosit(z.Re, re), Im: outPosit(z.Re, im)}
+}
+
+// Conj returns the complex conjugate, this is always exact
+func (z *SlowComplex) Conj() *SlowComplex {
+	return &SlowComplex{Re: z.Re.Clone(), Im: z.Im.neg()}
+}
+
+// absFloat returns |z| rounded to odd so that it can be rounded once more to the posit
+func (z *SlowComplex) absFloat() *big.Float {
+	rr := z.Re.MulPromote(z.Re).ToFloat()
+	ii := z.Im.MulPromote(z.Im).ToFloat()
+	sum := exactAdd(rr, ii)
+	return sqrtOdd(sum, z.Re.hiPrec())
+}
+
+// Abs returns the absolute value of z, the squares are computed with MulPromote and
+// summed exactly so the result rounds only once.
+func (z *SlowComplex) Abs() *SlowPosit {
+	if z.isNaR() {
+		return z.Re.Clone().NaR()
+	}
+	if z.Re.isZero() && z.Im.isZero() {
+		return z.Re.Clone().Zero()
+	}
+	return outPosit(z.Re, z.absFloat())
+}
+
+// Phase returns the argument of z in radians, between -pi and pi
+func (z *SlowComplex) Phase() *SlowPosit {
+	if z.isNaR() {
+		return z.Re.Clone().NaR()
+	}
+	return outPosit(z.Re, BigAtan2(z.Im.ToFloat(), z.Re.ToFloat(), z.Re.hiPrec()))
+}
+
+// Polar returns the absolute value and the phase of z
+func (z *SlowComplex) Polar() (r, theta *SlowPosit) {
+	return z.Abs(), z.Phase()
+}
+
+// Sqrt returns the principal square root of z, each part is computed in high precision
+// and rounded to nearest even.
+func (z *SlowComplex) Sqrt() *SlowComplex {
+	if z.isNaR() {
+		return nanComplex(z.Re)
+	}
+	if z.Re.isZero() && z.Im.isZero() {
+		return &SlowComplex{Re: z.Re.Clone().Zero(), Im: z.Re.Clone().Zero()}
+	}
+	prec := z.Re.hiPrec()
+	a := z.Re.ToFloat()
+	b := z.Im.ToFloat()
+
+	// t = sqrt((|z| + |a|) / 2)
+	t := newBig(prec).Abs(a)
+	t.Add(t, z.absFloat())
+	Shf(t, -1)
+	t.Sqrt(t)
+
+	// u = |b| / 2t
+	u := newBig(prec).Abs(b)
+	u.Quo(u, t)
+	Shf(u, -1)
+
+	if a.Sign() >= 0 {
+		if b.Sign() < 0 {
+			u.Neg(u)
+		}
+		return &SlowComplex{Re: outPosit(z.Re, t), Im: outPosit(z.Re, u)}
+	}
+	if b.Sign() < 0 {
+		t.Neg(t)
+	}
+	return &SlowComplex{Re: outPosit(z.Re, u), Im: outPosit(z.Re, t)}
+}
+
+// Exp returns e**z, each part is computed in high precision and rounded to nearest even
+func (z *SlowComplex) Exp() *SlowComplex {
+	if z.isNaR() {
+		return nanComplex(z.Re)
+	}
+	prec := z.Re.hiPrec()
+	ea := BigExp(z.Re.ToFloat(), prec)
+	sin, cos := BigSinCos(z.Im.ToFloat(), prec)
+	cos.Mul(cos, ea)
+	sin.Mul(sin, ea)
+	return &SlowComplex{Re: outPosit(z.Re, cos), Im: outPosit(z.Re, sin)}
+}
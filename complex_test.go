@@ -0,0 +1,131 @@
+package goposit_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+func c32(re, im float64) goposit.Complex32 {
+	return goposit.NewComplex32(p16(re), p16(im))
+}
+
+func p16(f float64) goposit.Posit16 {
+	sp := goposit.NewSlowPosit(16, 1)
+	sp.FromFloat(big.NewFloat(f), false)
+	return goposit.NewPosit16().SetBits(uint16(sp.Uint64()))
+}
+
+func assertComplex(t *testing.T, name string, z, expect goposit.Complex32) {
+	if z.Real().Bits() != expect.Real().Bits() || z.Imag().Bits() != expect.Imag().Bits() {
+		t.Errorf("%v: got [%x %x] expected [%x %x]", name,
+			z.Real().Bits(), z.Imag().Bits(), expect.Real().Bits(), expect.Imag().Bits())
+	}
+}
+
+func TestComplex(t *testing.T) {
+	assertComplex(t, "Mul", c32(1, 2).Mul(c32(3, 4)), c32(-5, 10))
+	assertComplex(t, "Div", c32(-5, 10).Div(c32(3, 4)), c32(1, 2))
+	assertComplex(t, "Conj", c32(1, 2).Conj(), c32(1, -2))
+	assertComplex(t, "Add", c32(1, 2).Add(c32(3, -4)), c32(4, -2))
+	assertComplex(t, "Sqrt", c32(3, 4).Sqrt(), c32(2, 1))
+	assertComplex(t, "Sqrt -4", c32(-4, 0).Sqrt(), c32(0, 2))
+	assertComplex(t, "Sqrt -4i", c32(0, -8).Sqrt(), c32(2, -2))
+	assertComplex(t, "Exp", c32(0, 0).Exp(), c32(1, 0))
+
+	if c32(3, 4).Abs().Bits() != p16(5).Bits() {
+		t.Errorf("Abs: |3+4i| should be 5")
+	}
+	r, theta := c32(0, 2).Polar()
+	if r.Bits() != p16(2).Bits() || theta.Bits() != p16(math.Pi/2).Bits() {
+		t.Errorf("Polar: got [%x %x]", r.Bits(), theta.Bits())
+	}
+	z := goposit.NewComplex32Polar(p16(1), p16(math.Pi))
+	if z.Real().Bits() != p16(-1).Bits() {
+		t.Errorf("Polar: e**(i*pi) should round to -1, got [%x]", z.Real().Bits())
+	}
+	z = c32(0, 1).Div(c32(0, 0))
+	if z.Real().Bits() != 0x8000 || z.Imag().Bits() != 0x8000 {
+		t.Errorf("Div: division by zero should be NaR")
+	}
+}
+
+// Multiplication should round only once per part, check it against big.Float
+func TestComplexMulRounding(t *testing.T) {
+	sp := goposit.NewSlowPosit(16, 1)
+	toFloat := func(x goposit.Posit16) *big.Float {
+		sp.SetBits(new(big.Int).SetUint64(uint64(x.Bits())))
+		return sp.ToFloat()
+	}
+	round := func(f *big.Float) uint16 {
+		sp.FromFloat(f, false)
+		return uint16(sp.Uint64())
+	}
+	seed := uint32(12345)
+	next := func() goposit.Posit16 {
+		seed = seed*1664525 + 1013904223
+		bits := uint16(seed >> 16)
+		if bits == 0x8000 {
+			bits = 0
+		}
+		return goposit.NewPosit16().SetBits(bits)
+	}
+	for i := 0; i < 2000; i++ {
+		a, b, c, d := next(), next(), next(), next()
+		z := goposit.NewComplex32(a, b).Mul(goposit.NewComplex32(c, d))
+		ac := new(big.Float).SetPrec(1000).Mul(toFloat(a), toFloat(c))
+		bd := new(big.Float).SetPrec(1000).Mul(toFloat(b), toFloat(d))
+		ad := new(big.Float).SetPrec(1000).Mul(toFloat(a), toFloat(d))
+		bc := new(big.Float).SetPrec(1000).Mul(toFloat(b), toFloat(c))
+		re := round(ac.Sub(ac, bd))
+		im := round(ad.Add(ad, bc))
+		if z.Real().Bits() != re || z.Imag().Bits() != im {
+			t.Errorf("(%x+%xi)*(%x+%xi) = [%x %x] expected [%x %x]", a.Bits(), b.Bits(), c.Bits(), d.Bits(),
+				z.Real().Bits(), z.Imag().Bits(), re, im)
+		}
+	}
+}
+
+// Division and Abs should also round only once, check them against big.Rat and a wide sqrt
+func TestComplexDivRounding(t *testing.T) {
+	sp := goposit.NewSlowPosit(16, 1)
+	toRat := func(x goposit.Posit16) *big.Rat {
+		sp.SetBits(new(big.Int).SetUint64(uint64(x.Bits())))
+		return sp.Rat()
+	}
+	round := func(r *big.Rat) uint16 { return uint16(sp.FromRat(r).Uint64()) }
+	seed := uint32(54321)
+	next := func() goposit.Posit16 {
+		seed = seed*1664525 + 1013904223
+		bits := uint16(seed >> 16)
+		if bits == 0x8000 {
+			bits = 0
+		}
+		return goposit.NewPosit16().SetBits(bits)
+	}
+	mul := func(x, y *big.Rat) *big.Rat { return new(big.Rat).Mul(x, y) }
+	for i := 0; i < 2000; i++ {
+		a, b, c, d := next(), next(), next(), next()
+		if c.Bits() == 0 && d.Bits() == 0 {
+			continue
+		}
+		z := goposit.NewComplex32(a, b).Div(goposit.NewComplex32(c, d))
+		ar, br, cr, dr := toRat(a), toRat(b), toRat(c), toRat(d)
+		den := new(big.Rat).Add(mul(cr, cr), mul(dr, dr))
+		re := new(big.Rat).Add(mul(ar, cr), mul(br, dr))
+		im := new(big.Rat).Sub(mul(br, cr), mul(ar, dr))
+		wantRe, wantIm := round(re.Quo(re, den)), round(im.Quo(im, den))
+		if z.Real().Bits() != wantRe || z.Imag().Bits() != wantIm {
+			t.Errorf("(%x+%xi)/(%x+%xi) = [%x %x] expected [%x %x]", a.Bits(), b.Bits(), c.Bits(), d.Bits(),
+				z.Real().Bits(), z.Imag().Bits(), wantRe, wantIm)
+		}
+
+		abs := new(big.Float).SetPrec(1000).SetRat(new(big.Rat).Add(mul(ar, ar), mul(br, br)))
+		sp.FromFloat(abs.Sqrt(abs), false)
+		if got := goposit.NewComplex32(a, b).Abs().Bits(); got != uint16(sp.Uint64()) {
+			t.Errorf("|%x+%xi| = %x expected %x", a.Bits(), b.Bits(), got, sp.Uint64())
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package goposit_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+func TestConstants(t *testing.T) {
+	if goposit.Posit8NaR().Bits() != 0x80 || goposit.Posit8Zero().Bits() != 0 || goposit.Posit8One().Bits() != 0x40 {
+		t.Errorf("Posit8 NaR, Zero or One is wrong")
+	}
+	if goposit.Posit16MaxPos().Bits() != 0x7fff || goposit.Posit16MinPos().Bits() != 0x0001 {
+		t.Errorf("Posit16 MaxPos or MinPos is wrong")
+	}
+	if goposit.Posit64MaxPos().NextUp().Bits() != goposit.Posit64NaR().Bits() {
+		t.Errorf("Posit64 MaxPos should be just below NaR")
+	}
+	for _, c := range []struct {
+		name   string
+		bits   uint16
+		expect float64
+	}{
+		{"Pi", goposit.Posit16Pi().Bits(), math.Pi},
+		{"E", goposit.Posit16E().Bits(), math.E},
+		{"Ln2", goposit.Posit16Ln2().Bits(), math.Ln2},
+		{"Sqrt2", goposit.Posit16Sqrt2().Bits(), math.Sqrt2},
+	} {
+		if c.bits != p16(c.expect).Bits() {
+			t.Errorf("Posit16%v is %x, expected %x", c.name, c.bits, p16(c.expect).Bits())
+		}
+	}
+	// float64 has more bits than a Posit32 fraction so rounding it gives the same answer
+	sp := goposit.NewSlowPosit(32, 2)
+	sp.FromFloat(big.NewFloat(math.Pi), false)
+	if uint64(goposit.Posit32Pi().Bits()) != sp.Uint64() {
+		t.Errorf("Posit32Pi is %x, expected %x", goposit.Posit32Pi().Bits(), sp.Uint64())
+	}
+}
@@ -0,0 +1,73 @@
+// Package dsp provides signal processing primitives over posit vectors. Every output
+// value of a filter or convolution, and of each FFT stage, is accumulated exactly and
+// rounded only once so that posit signal to noise ratio can be compared fairly against
+// IEEE floats.
+package dsp
+
+import (
+	"math/big"
+
+	"github.com/cjdelisle/goposit"
+)
+
+// accumulator is an exact sum of products of posits, like a quire it is wide enough that
+// adding up to 2**64 products of the posit size can never round.
+type accumulator struct {
+	template *goposit.SlowPosit
+	sum      *big.Float
+	nar      bool
+}
+
+func newAccumulator(template *goposit.SlowPosit) *accumulator {
+	maxExp := uint(template.Log2MaxVal())
+	prec := 4*maxExp + 2*template.Nbits() + 64
+	return &accumulator{template: template, sum: new(big.Float).SetPrec(prec)}
+}
+
+func (a *accumulator) add(x *big.Float) {
+	if x.IsInf() {
+		a.nar = true
+		return
+	}
+	a.sum.Add(a.sum, x)
+	if a.sum.Acc() != big.Exact {
+		panic("accumulator lost bits")
+	}
+}
+
+// addMul adds x*y to the accumulator, or subtracts it if neg is set
+func (a *accumulator) addMul(x, y *goposit.SlowPosit, neg bool) {
+	if x.IsNaR() || y.IsNaR() {
+		a.nar = true
+		return
+	}
+	xf := x.ToFloat()
+	yf := y.ToFloat()
+	prod := new(big.Float).SetPrec(xf.Prec()+yf.Prec()).Mul(xf, yf)
+	if neg {
+		prod.Neg(prod)
+	}
+	a.add(prod)
+}
+
+// result rounds the accumulated value to nearest even
+func (a *accumulator) result() *goposit.SlowPosit {
+	out := goposit.NewSlowPosit(a.template.Nbits(), a.template.Es())
+	if a.nar {
+		return out.NaR()
+	}
+	out.FromFloat(a.sum, false)
+	return out
+}
+
+// quotient rounds the accumulated value divided by d, the result rounds only once
+func (a *accumulator) quotient(d *goposit.SlowPosit) *goposit.SlowPosit {
+	out := goposit.NewSlowPosit(a.template.Nbits(), a.template.Es())
+	if a.nar || d.IsNaR() || d.Bits.Sign() == 0 {
+		return out.NaR()
+	}
+	// the sum and d are exact so FromRat rounds their quotient only once
+	sum, _ := a.sum.Rat(nil)
+	den, _ := d.ToFloat().Rat(nil)
+	return out.FromRat(sum.Quo(sum, den))
+}
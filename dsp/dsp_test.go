@@ -0,0 +1,115 @@
+package dsp_test
+
+import (
+	"math"
+	"math/big"
+	"math/cmplx"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+	"github.com/cjdelisle/goposit/dsp"
+)
+
+func p16(f float64) goposit.Posit16 {
+	sp := goposit.NewSlowPosit(16, 1)
+	sp.FromFloat(big.NewFloat(f), false)
+	return goposit.NewPosit16().SetBits(uint16(sp.Uint64()))
+}
+
+func f16(p goposit.Posit16) float64 {
+	sp := goposit.NewSlowPosit(16, 1)
+	sp.SetBits(new(big.Int).SetUint64(uint64(p.Bits())))
+	f, _ := sp.ToFloat().Float64()
+	return f
+}
+
+func vec16(vals ...float64) []goposit.Posit16 {
+	out := make([]goposit.Posit16, len(vals))
+	for i, v := range vals {
+		out[i] = p16(v)
+	}
+	return out
+}
+
+func assertVec(t *testing.T, name string, got []goposit.Posit16, expect ...float64) {
+	if len(got) != len(expect) {
+		t.Errorf("%v: expected %d outputs, got %d", name, len(expect), len(got))
+		return
+	}
+	for i, e := range expect {
+		if got[i].Bits() != p16(e).Bits() {
+			t.Errorf("%v: [%d] = %v expected %v", name, i, f16(got[i]), e)
+		}
+	}
+}
+
+func TestFilters(t *testing.T) {
+	assertVec(t, "FIR", dsp.FIR16(vec16(1, 2), vec16(1, 1, 1)), 1, 3, 3)
+	assertVec(t, "Convolve", dsp.Convolve16(vec16(1, 2), vec16(1, 1, 1)), 1, 3, 3, 2)
+	assertVec(t, "IIR", dsp.IIR16(vec16(1), vec16(1, -0.5), vec16(1, 0, 0, 0)), 1, 0.5, 0.25, 0.125)
+	assertVec(t, "IIR a0", dsp.IIR16(vec16(1), vec16(2), vec16(1, 3)), 0.5, 1.5)
+}
+
+func signal(n int) []goposit.Complex32 {
+	x := make([]goposit.Complex32, n)
+	for i := range x {
+		x[i] = goposit.NewComplex32(p16(math.Sin(float64(i)*0.7)), p16(math.Cos(float64(i)*1.3)/2))
+	}
+	return x
+}
+
+// snr compares the posit transform to a float64 DFT of the same (posit valued) input
+func snr(x, y []goposit.Complex32, sign float64) float64 {
+	var sig, noise float64
+	n := len(x)
+	for k := 0; k < n; k++ {
+		var ref complex128
+		for j := 0; j < n; j++ {
+			xj := complex(f16(x[j].Real()), f16(x[j].Imag()))
+			ref += xj * cmplx.Exp(complex(0, sign*2*math.Pi*float64(j*k)/float64(n)))
+		}
+		got := complex(f16(y[k].Real()), f16(y[k].Imag()))
+		sig += cmplx.Abs(ref) * cmplx.Abs(ref)
+		noise += cmplx.Abs(got-ref) * cmplx.Abs(got-ref)
+	}
+	return 10 * math.Log10(sig/noise)
+}
+
+func TestFFT(t *testing.T) {
+	impulse := make([]goposit.Complex32, 8)
+	for i := range impulse {
+		impulse[i] = goposit.NewComplex32(p16(0), p16(0))
+	}
+	impulse[0] = goposit.NewComplex32(p16(1), p16(0))
+	for i, z := range dsp.FFT16(impulse) {
+		if z.Real().Bits() != p16(1).Bits() || z.Imag().Bits() != 0 {
+			t.Errorf("FFT of impulse [%d] is not 1", i)
+		}
+	}
+
+	// powers of two, mixed radix and prime lengths
+	for _, n := range []int{8, 16, 6, 12, 7} {
+		x := signal(n)
+		if s := snr(x, dsp.FFT16(x), -1); s < 50 {
+			t.Errorf("FFT length %d: SNR %v dB is too low", n, s)
+		}
+		back := dsp.IFFT16(dsp.FFT16(x))
+		for i := range x {
+			if math.Abs(f16(back[i].Real())-f16(x[i].Real())) > 1e-2 ||
+				math.Abs(f16(back[i].Imag())-f16(x[i].Imag())) > 1e-2 {
+				t.Errorf("IFFT length %d: [%d] does not round trip", n, i)
+			}
+		}
+	}
+
+	re := dsp.RealFFT16(vec16(1, 1, 1, 1, 1, 1))
+	if len(re) != 4 || re[0].Real().Bits() != p16(6).Bits() {
+		t.Errorf("RealFFT of a constant should be a single bin")
+	}
+	// the twiddles are rounded so the other bins only cancel approximately
+	for _, z := range re[1:] {
+		if math.Abs(f16(z.Real())) > 1e-3 || math.Abs(f16(z.Imag())) > 1e-3 {
+			t.Errorf("RealFFT of a constant should be near zero outside of bin 0")
+		}
+	}
+}
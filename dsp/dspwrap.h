@@ -0,0 +1,70 @@
+
+__COMMENT__ GLUE(slow, NBITS) converts a ONE_T to a SlowPosit
+func GLUE(slow, NBITS)(p goposit.ONE_T) *goposit.SlowPosit {
+    out := goposit.NewSlowPosit(NBITS, ES)
+    out.SetBits(new(big.Int).SetUint64(uint64(p.Bits())))
+    return out
+}
+
+__COMMENT__ GLUE(fast, NBITS) converts a SlowPosit back to a ONE_T
+func GLUE(fast, NBITS)(p *goposit.SlowPosit) goposit.ONE_T {
+    return goposit.GLUE(NewPosit, NBITS)().SetBits(UWORD(p.Uint64()))
+}
+
+__COMMENT__ GLUE(slowSlice, NBITS) converts a slice of ONE_T to SlowPosits
+func GLUE(slowSlice, NBITS)(x []goposit.ONE_T) []*goposit.SlowPosit {
+    out := make([]*goposit.SlowPosit, len(x))
+    for i, p := range x { out[i] = GLUE(slow, NBITS)(p) }
+    return out
+}
+
+__COMMENT__ GLUE(fastSlice, NBITS) converts a slice of SlowPosits to ONE_T
+func GLUE(fastSlice, NBITS)(x []*goposit.SlowPosit) []goposit.ONE_T {
+    out := make([]goposit.ONE_T, len(x))
+    for i, p := range x { out[i] = GLUE(fast, NBITS)(p) }
+    return out
+}
+
+__COMMENT__ GLUE(slowComplex, NBITS) converts a slice of CPLX_T to SlowComplex
+func GLUE(slowComplex, NBITS)(x []goposit.CPLX_T) []*goposit.SlowComplex {
+    out := make([]*goposit.SlowComplex, len(x))
+    for i, z := range x { out[i] = goposit.NewSlowComplex(GLUE(slow, NBITS)(z.Real()), GLUE(slow, NBITS)(z.Imag())) }
+    return out
+}
+
+__COMMENT__ GLUE(fastComplex, NBITS) converts a slice of SlowComplex to CPLX_T
+func GLUE(fastComplex, NBITS)(x []*goposit.SlowComplex) []goposit.CPLX_T {
+    out := make([]goposit.CPLX_T, len(x))
+    for i, z := range x { out[i] = goposit.GLUE(New, CPLX_T)(GLUE(fast, NBITS)(z.Re), GLUE(fast, NBITS)(z.Im)) }
+    return out
+}
+
+__COMMENT__ GLUE(FFT, NBITS) provides a thin wrapper around FFT
+func GLUE(FFT, NBITS)(x []goposit.CPLX_T) []goposit.CPLX_T {
+    return GLUE(fastComplex, NBITS)(FFT(GLUE(slowComplex, NBITS)(x)))
+}
+
+__COMMENT__ GLUE(IFFT, NBITS) provides a thin wrapper around IFFT
+func GLUE(IFFT, NBITS)(x []goposit.CPLX_T) []goposit.CPLX_T {
+    return GLUE(fastComplex, NBITS)(IFFT(GLUE(slowComplex, NBITS)(x)))
+}
+
+__COMMENT__ GLUE(RealFFT, NBITS) provides a thin wrapper around RealFFT
+func GLUE(RealFFT, NBITS)(x []goposit.ONE_T) []goposit.CPLX_T {
+    return GLUE(fastComplex, NBITS)(RealFFT(GLUE(slowSlice, NBITS)(x)))
+}
+
+__COMMENT__ GLUE(FIR, NBITS) provides a thin wrapper around FIR
+func GLUE(FIR, NBITS)(h, x []goposit.ONE_T) []goposit.ONE_T {
+    return GLUE(fastSlice, NBITS)(FIR(GLUE(slowSlice, NBITS)(h), GLUE(slowSlice, NBITS)(x)))
+}
+
+__COMMENT__ GLUE(IIR, NBITS) provides a thin wrapper around IIR
+func GLUE(IIR, NBITS)(b, a, x []goposit.ONE_T) []goposit.ONE_T {
+    return GLUE(fastSlice, NBITS)(IIR(GLUE(slowSlice, NBITS)(b), GLUE(slowSlice, NBITS)(a), GLUE(slowSlice, NBITS)(x)))
+}
+
+__COMMENT__ GLUE(Convolve, NBITS) provides a thin wrapper around Convolve
+func GLUE(Convolve, NBITS)(x, y []goposit.ONE_T) []goposit.ONE_T {
+    return GLUE(fastSlice, NBITS)(Convolve(GLUE(slowSlice, NBITS)(x), GLUE(slowSlice, NBITS)(y)))
+}
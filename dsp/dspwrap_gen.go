@@ -0,0 +1,162 @@
+package dsp
+
+import (
+	"github.com/cjdelisle/goposit"
+	"math/big"
+)
+
+// slow16 converts a Posit16 to a SlowPosit
+func slow16(p goposit.Posit16) *goposit.SlowPosit {
+	out := goposit.NewSlowPosit(16, 1)
+	out.SetBits(new(big.Int).SetUint64(uint64(p.Bits())))
+	return out
+}
+
+// fast16 converts a SlowPosit back to a Posit16
+func fast16(p *goposit.SlowPosit) goposit.Posit16 {
+	return goposit.NewPosit16().SetBits(uint16(p.Uint64()))
+}
+
+// slowSlice16 converts a slice of Posit16 to SlowPosits
+func slowSlice16(x []goposit.Posit16) []*goposit.SlowPosit {
+	out := make([]*goposit.SlowPosit, len(x))
+	for i, p := range x {
+		out[i] = slow16(p)
+	}
+	return out
+}
+
+// fastSlice16 converts a slice of SlowPosits to Posit16
+func fastSlice16(x []*goposit.SlowPosit) []goposit.Posit16 {
+	out := make([]goposit.Posit16, len(x))
+	for i, p := range x {
+		out[i] = fast16(p)
+	}
+	return out
+}
+
+// slowComplex16 converts a slice of Complex32 to SlowComplex
+func slowComplex16(x []goposit.Complex32) []*goposit.SlowComplex {
+	out := make([]*goposit.SlowComplex, len(x))
+	for i, z := range x {
+		out[i] = goposit.NewSlowComplex(slow16(z.Real()), slow16(z.Imag()))
+	}
+	return out
+}
+
+// fastComplex16 converts a slice of SlowComplex to Complex32
+func fastComplex16(x []*goposit.SlowComplex) []goposit.Complex32 {
+	out := make([]goposit.Complex32, len(x))
+	for i, z := range x {
+		out[i] = goposit.NewComplex32(fast16(z.Re), fast16(z.Im))
+	}
+	return out
+}
+
+// FFT16 provides a thin wrapper around FFT
+func FFT16(x []goposit.Complex32) []goposit.Complex32 {
+	return fastComplex16(FFT(slowComplex16(x)))
+}
+
+// IFFT16 provides a thin wrapper around IFFT
+func IFFT16(x []goposit.Complex32) []goposit.Complex32 {
+	return fastComplex16(IFFT(slowComplex16(x)))
+}
+
+// RealFFT16 provides a thin wrapper around RealFFT
+func RealFFT16(x []goposit.Posit16) []goposit.Complex32 {
+	return fastComplex16(RealFFT(slowSlice16(x)))
+}
+
+// FIR16 provides a thin wrapper around FIR
+func FIR16(h, x []goposit.Posit16) []goposit.Posit16 {
+	return fastSlice16(FIR(slowSlice16(h), slowSlice16(x)))
+}
+
+// IIR16 provides a thin wrapper around IIR
+func IIR16(b, a, x []goposit.Posit16) []goposit.Posit16 {
+	return fastSlice16(IIR(slowSlice16(b), slowSlice16(a), slowSlice16(x)))
+}
+
+// Convolve16 provides a thin wrapper around Convolve
+func Convolve16(x, y []goposit.Posit16) []goposit.Posit16 {
+	return fastSlice16(Convolve(slowSlice16(x), slowSlice16(y)))
+}
+
+// slow32 converts a Posit32 to a SlowPosit
+func slow32(p goposit.Posit32) *goposit.SlowPosit {
+	out := goposit.NewSlowPosit(32, 2)
+	out.SetBits(new(big.Int).SetUint64(uint64(p.Bits())))
+	return out
+}
+
+// fast32 converts a SlowPosit back to a Posit32
+func fast32(p *goposit.SlowPosit) goposit.Posit32 {
+	return goposit.NewPosit32().SetBits(uint32(p.Uint64()))
+}
+
+// slowSlice32 converts a slice of Posit32 to SlowPosits
+func slowSlice32(x []goposit.Posit32) []*goposit.SlowPosit {
+	out := make([]*goposit.SlowPosit, len(x))
+	for i, p := range x {
+		out[i] = slow32(p)
+	}
+	return out
+}
+
+// fastSlice32 converts a slice of SlowPosits to Posit32
+func fastSlice32(x []*goposit.SlowPosit) []goposit.Posit32 {
+	out := make([]goposit.Posit32, len(x))
+	for i, p := range x {
+		out[i] = fast32(p)
+	}
+	return out
+}
+
+// slowComplex32 converts a slice of Complex64 to SlowComplex
+func slowComplex32(x []goposit.Complex64) []*goposit.SlowComplex {
+	out := make([]*goposit.SlowComplex, len(x))
+	for i, z := range x {
+		out[i] = goposit.NewSlowComplex(slow32(z.Real()), slow32(z.Imag()))
+	}
+	return out
+}
+
+// fastComplex32 converts a slice of SlowComplex to Complex64
+func fastComplex32(x []*goposit.SlowComplex) []goposit.Complex64 {
+	out := make([]goposit.Complex64, len(x))
+	for i, z := range x {
+		out[i] = goposit.NewComplex64(fast32(z.Re), fast32(z.Im))
+	}
+	return out
+}
+
+// FFT32 provides a thin wrapper around FFT
+func FFT32(x []goposit.Complex64) []goposit.Complex64 {
+	return fastComplex32(FFT(slowComplex32(x)))
+}
+
+// IFFT32 provides a thin wrapper around IFFT
+func IFFT32(x []goposit.Complex64) []goposit.Complex64 {
+	return fastComplex32(IFFT(slowComplex32(x)))
+}
+
+// RealFFT32 provides a thin wrapper around RealFFT
+func RealFFT32(x []goposit.Posit32) []goposit.Complex64 {
+	return fastComplex32(RealFFT(slowSlice32(x)))
+}
+
+// FIR32 provides a thin wrapper around FIR
+func FIR32(h, x []goposit.Posit32) []goposit.Posit32 {
+	return fastSlice32(FIR(slowSlice32(h), slowSlice32(x)))
+}
+
+// IIR32 provides a thin wrapper around IIR
+func IIR32(b, a, x []goposit.Posit32) []goposit.Posit32 {
+	return fastSlice32(IIR(slowSlice32(b), slowSlice32(a), slowSlice32(x)))
+}
+
+// Convolve32 provides a thin wrapper around Convolve
+func Convolve32(x, y []goposit.Posit32) []goposit.Posit32 {
+	return fastSlice32(Convolve(slowSlice32(x), slowSlice32(y)))
+}
@@ -0,0 +1,110 @@
+package dsp
+
+import (
+	"math/big"
+
+	"github.com/cjdelisle/goposit"
+)
+
+// twiddles computes exp(sign*2*pi*i*k/n) for k in [0, n), each part is computed in high
+// precision and rounded to nearest even.
+func twiddles(template *goposit.SlowPosit, n int, sign int) []*goposit.SlowComplex {
+	prec := 2*template.Nbits() + 64
+	out := make([]*goposit.SlowComplex, n)
+	for k := range out {
+		angle := goposit.BigPi(prec)
+		goposit.Shf(angle, 1)
+		angle.Mul(angle, new(big.Float).SetInt64(int64(sign*k)))
+		angle.Quo(angle, new(big.Float).SetInt64(int64(n)))
+		sin, cos := goposit.BigSinCos(angle, prec)
+		re := goposit.NewSlowPosit(template.Nbits(), template.Es())
+		im := goposit.NewSlowPosit(template.Nbits(), template.Es())
+		re.FromFloat(cos, false)
+		im.FromFloat(sin, false)
+		out[k] = goposit.NewSlowComplex(re, im)
+	}
+	return out
+}
+
+func smallestFactor(n int) int {
+	for f := 2; f*f <= n; f++ {
+		if n%f == 0 {
+			return f
+		}
+	}
+	return n
+}
+
+// fft is a recursive mixed radix decimation in time transform, w holds the twiddles
+// for the full length and stride says how far apart the ones for this length are.
+func fft(x []*goposit.SlowComplex, w []*goposit.SlowComplex, stride int) []*goposit.SlowComplex {
+	n := len(x)
+	if n == 1 {
+		return []*goposit.SlowComplex{x[0]}
+	}
+	r := smallestFactor(n)
+	m := n / r
+	subs := make([][]*goposit.SlowComplex, r)
+	for j := range subs {
+		part := make([]*goposit.SlowComplex, m)
+		for i := range part {
+			part[i] = x[i*r+j]
+		}
+		subs[j] = fft(part, w, stride*r)
+	}
+	template := x[0].Re
+	out := make([]*goposit.SlowComplex, n)
+	for k := 0; k < m; k++ {
+		for q := 0; q < r; q++ {
+			idx := k + m*q
+			re := newAccumulator(template)
+			im := newAccumulator(template)
+			for j := 0; j < r; j++ {
+				t := w[(j*idx%n)*stride]
+				s := subs[j][k]
+				re.addMul(t.Re, s.Re, false)
+				re.addMul(t.Im, s.Im, true)
+				im.addMul(t.Re, s.Im, false)
+				im.addMul(t.Im, s.Re, false)
+			}
+			out[idx] = goposit.NewSlowComplex(re.result(), im.result())
+		}
+	}
+	return out
+}
+
+// FFT computes the discrete fourier transform of x using a mixed radix Cooley-Tukey
+// algorithm, powers of two use radix 2 and other lengths are broken down by their
+// prime factors. Each output of each stage is accumulated exactly and rounded once.
+func FFT(x []*goposit.SlowComplex) []*goposit.SlowComplex {
+	if len(x) == 0 {
+		return nil
+	}
+	return fft(x, twiddles(x[0].Re, len(x), -1), 1)
+}
+
+// IFFT computes the inverse discrete fourier transform of x, including the 1/n scaling
+func IFFT(x []*goposit.SlowComplex) []*goposit.SlowComplex {
+	if len(x) == 0 {
+		return nil
+	}
+	out := fft(x, twiddles(x[0].Re, len(x), 1), 1)
+	n := x[0].Re.FromInt(int64(len(x)))
+	for i, z := range out {
+		out[i] = goposit.NewSlowComplex(z.Re.Div(n), z.Im.Div(n))
+	}
+	return out
+}
+
+// RealFFT computes the discrete fourier transform of a real signal, only the first
+// len(x)/2+1 outputs are returned because the rest are their complex conjugates.
+func RealFFT(x []*goposit.SlowPosit) []*goposit.SlowComplex {
+	if len(x) == 0 {
+		return nil
+	}
+	in := make([]*goposit.SlowComplex, len(x))
+	for i, p := range x {
+		in[i] = goposit.NewSlowComplex(p, goposit.NewSlowPosit(p.Nbits(), p.Es()))
+	}
+	return FFT(in)[:len(x)/2+1]
+}
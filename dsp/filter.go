@@ -0,0 +1,60 @@
+package dsp
+
+import (
+	"github.com/cjdelisle/goposit"
+)
+
+// FIR applies a finite impulse response filter with coefficients h to the signal x,
+// y[n] = sum(h[k] * x[n-k]), samples before the start of x are taken to be zero. Each
+// output is accumulated exactly and rounded once.
+func FIR(h, x []*goposit.SlowPosit) []*goposit.SlowPosit {
+	out := make([]*goposit.SlowPosit, len(x))
+	for n := range x {
+		acc := newAccumulator(x[n])
+		for k := 0; k < len(h) && k <= n; k++ {
+			acc.addMul(h[k], x[n-k], false)
+		}
+		out[n] = acc.result()
+	}
+	return out
+}
+
+// IIR applies an infinite impulse response filter in direct form I,
+// a[0]*y[n] = sum(b[k] * x[n-k]) - sum(a[k] * y[n-k]) for k >= 1. Each output is
+// accumulated exactly and rounded once after dividing by a[0].
+func IIR(b, a, x []*goposit.SlowPosit) []*goposit.SlowPosit {
+	if len(a) == 0 {
+		panic("IIR filter requires at least one feedback coefficient")
+	}
+	out := make([]*goposit.SlowPosit, len(x))
+	for n := range x {
+		acc := newAccumulator(x[n])
+		for k := 0; k < len(b) && k <= n; k++ {
+			acc.addMul(b[k], x[n-k], false)
+		}
+		for k := 1; k < len(a) && k <= n; k++ {
+			acc.addMul(a[k], out[n-k], true)
+		}
+		out[n] = acc.quotient(a[0])
+	}
+	return out
+}
+
+// Convolve returns the full linear convolution of x and y which has length
+// len(x)+len(y)-1, each output is accumulated exactly and rounded once.
+func Convolve(x, y []*goposit.SlowPosit) []*goposit.SlowPosit {
+	if len(x) == 0 || len(y) == 0 {
+		return nil
+	}
+	out := make([]*goposit.SlowPosit, len(x)+len(y)-1)
+	for n := range out {
+		acc := newAccumulator(x[0])
+		for k := 0; k < len(x); k++ {
+			if n-k >= 0 && n-k < len(y) {
+				acc.addMul(x[k], y[n-k], false)
+			}
+		}
+		out[n] = acc.result()
+	}
+	return out
+}
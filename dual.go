@@ -0,0 +1,331 @@
+package goposit
+
+import (
+	"math/big"
+)
+
+// SlowDual is a dual number Val + Der*e where e*e = 0, arithmetic on dual numbers carries
+// the derivative along with the value (forward mode automatic differentiation). To get the
+// derivative of f at x, evaluate f on NewSlowDual(x, 1) and read Der.
+//
+// The derivatives of Add, Sub, Mul, Div and Log are rounded only once: products are exact
+// and summed exactly and quotients are rounded to odd before they are rounded to the posit.
+// The other elementary functions are evaluated in high precision and rounded to nearest
+// even, their derivatives are the product of two such numbers.
+type SlowDual struct {
+	Val *SlowPosit
+	Der *SlowPosit
+}
+
+// NewSlowDual creates the dual number val + der*e
+func NewSlowDual(val, der *SlowPosit) *SlowDual {
+	assertCompat(val, der)
+	return &SlowDual{Val: val, Der: der}
+}
+
+func nanDual(template *SlowPosit) *SlowDual {
+	return &SlowDual{Val: template.Clone().NaR(), Der: template.Clone().NaR()}
+}
+
+func (z *SlowDual) isNaR() bool { return z.Val.IsNaR() || z.Der.IsNaR() }
+
+// exactMul returns x*y without rounding
+func exactMul(x, y *big.Float) *big.Float {
+	return new(big.Float).SetPrec(x.Prec()+y.Prec()).Mul(x, y)
+}
+
+// Add returns z + x
+func (z *SlowDual) Add(x *SlowDual) *SlowDual {
+	return &SlowDual{Val: z.Val.Add(x.Val), Der: z.Der.Add(x.Der)}
+}
+
+// Sub returns z - x
+func (z *SlowDual) Sub(x *SlowDual) *SlowDual {
+	return &SlowDual{Val: z.Val.Sub(x.Val), Der: z.Der.Sub(x.Der)}
+}
+
+// Neg returns -z, this is always exact
+func (z *SlowDual) Neg() *SlowDual {
+	return &SlowDual{Val: z.Val.neg(), Der: z.Der.neg()}
+}
+
+// Mul returns z * x, the derivative a*d + b*c is summed exactly and rounded once
+func (z *SlowDual) Mul(x *SlowDual) *SlowDual {
+	if z.isNaR() || x.isNaR() {
+		return nanDual(z.Val)
+	}
+	a, b := z.Val.ToFloat(), z.Der.ToFloat()
+	c, d := x.Val.ToFloat(), x.Der.ToFloat()
+	return &SlowDual{Val: z.Val.Mul(x.Val), Der: fusedSum(z.Val, exactMul(a, d), exactMul(b, c))}
+}
+
+// Div returns z / x, the derivative (b*c - a*d) / c**2 has an exact numerator and
+// denominator. Division by zero results in NaR.
+func (z *SlowDual) Div(x *SlowDual) *SlowDual {
+	if z.isNaR() || x.isNaR() || x.Val.isZero() {
+		return nanDual(z.Val)
+	}
+	a, b := z.Val.ToFloat(), z.Der.ToFloat()
+	c, d := x.Val.ToFloat(), x.Der.ToFloat()
+	ad := exactMul(a, d)
+	num := exactAdd(exactMul(b, c), ad.Neg(ad))
+	der := quoOdd(num, exactMul(c, c), z.Val.hiPrec())
+	return &SlowDual{Val: z.Val.Div(x.Val), Der: outPosit(z.Val, der)}
+}
+
+// chain returns the dual number val + df*Der*e where df is the derivative of the
+// function at Val, a nil df means the derivative is undefined.
+func (z *SlowDual) chain(val *SlowPosit, df *big.Float) *SlowDual {
+	if val.IsNaR() || z.Der.IsNaR() || df == nil || df.IsInf() {
+		return &SlowDual{Val: val, Der: z.Val.Clone().NaR()}
+	}
+	return &SlowDual{Val: val, Der: outPosit(z.Val, newBig(z.Val.hiPrec()).Mul(df, z.Der.ToFloat()))}
+}
+
+// Sqrt returns the square root of z, the derivative at zero is undefined
+func (z *SlowDual) Sqrt() *SlowDual {
+	if z.isNaR() {
+		return nanDual(z.Val)
+	}
+	val := z.Val.Sqrt()
+	if val.IsNaR() || z.Val.isZero() {
+		return z.chain(val, nil)
+	}
+	prec := z.Val.hiPrec()
+	s := newBig(prec).Sqrt(z.Val.ToFloat())
+	Shf(s, 1)
+	return z.chain(val, s.Quo(newBig(prec).SetInt64(1), s))
+}
+
+// Exp returns e**z
+func (z *SlowDual) Exp() *SlowDual {
+	if z.isNaR() {
+		return nanDual(z.Val)
+	}
+	e := BigExp(z.Val.ToFloat(), z.Val.hiPrec())
+	return z.chain(outPosit(z.Val, e), e)
+}
+
+// Log returns the natural logarithm of z, which must be positive, the derivative Der/Val
+// is rounded only once
+func (z *SlowDual) Log() *SlowDual {
+	if z.isNaR() || z.Val.ToFloat().Sign() <= 0 {
+		return nanDual(z.Val)
+	}
+	prec := z.Val.hiPrec()
+	x := z.Val.ToFloat()
+	return &SlowDual{Val: outPosit(z.Val, BigLog(x, prec)), Der: outPosit(z.Val, quoOdd(z.Der.ToFloat(), x, prec))}
+}
+
+// Sin returns the sine of z
+func (z *SlowDual) Sin() *SlowDual {
+	if z.isNaR() {
+		return nanDual(z.Val)
+	}
+	sin, cos := BigSinCos(z.Val.ToFloat(), z.Val.hiPrec())
+	return z.chain(outPosit(z.Val, sin), cos)
+}
+
+// Cos returns the cosine of z
+func (z *SlowDual) Cos() *SlowDual {
+	if z.isNaR() {
+		return nanDual(z.Val)
+	}
+	sin, cos := BigSinCos(z.Val.ToFloat(), z.Val.hiPrec())
+	return z.chain(outPosit(z.Val, cos), sin.Neg(sin))
+}
+
+// bigTanh returns tanh(x) = (e**2x - 1) / (e**2x + 1)
+func bigTanh(x *big.Float, prec uint) *big.Float {
+	wp := prec + bigGuardBits
+	x2 := newBig(wp).Set(x)
+	Shf(x2, 1)
+	e := BigExp(x2, wp)
+	num := newBig(wp).Sub(e, bigf1)
+	return newBig(prec).Quo(num, e.Add(e, bigf1))
+}
+
+// Tanh returns the hyperbolic tangent of z
+func (z *SlowDual) Tanh() *SlowDual {
+	if z.isNaR() {
+		return nanDual(z.Val)
+	}
+	prec := z.Val.hiPrec()
+	t := bigTanh(z.Val.ToFloat(), prec)
+	df := newBig(prec).Mul(t, t)
+	return z.chain(outPosit(z.Val, t), df.Sub(bigf1, df))
+}
+
+// Atan returns the arc tangent of z
+func (z *SlowDual) Atan() *SlowDual {
+	if z.isNaR() {
+		return nanDual(z.Val)
+	}
+	prec := z.Val.hiPrec()
+	x := z.Val.ToFloat()
+	df := newBig(prec).Mul(x, x)
+	df.Quo(bigf1, df.Add(df, bigf1))
+	return z.chain(outPosit(z.Val, BigAtan2(x, bigf1, prec)), df)
+}
+
+// SlowTape records operations on SlowVars so that the gradient of a scalar result with
+// respect to every input can be found in one backward pass (reverse mode automatic
+// differentiation).
+//
+// The partial derivative of each operation is rounded to a posit as it is by SlowDual, the
+// adjoints are accumulated exactly and each is rounded only once, when it is complete, as
+// if they were summed in a quire.
+type SlowTape struct {
+	nodes []tapeNode
+}
+
+type tapeNode struct {
+	value    *SlowPosit
+	parents  []int
+	partials []*SlowPosit
+}
+
+// SlowVar is a value on a SlowTape
+type SlowVar struct {
+	Value *SlowPosit
+	tape  *SlowTape
+	index int
+}
+
+// NewSlowTape creates an empty tape
+func NewSlowTape() *SlowTape { return &SlowTape{} }
+
+// Len returns the number of values on the tape
+func (t *SlowTape) Len() int { return len(t.nodes) }
+
+// Reset removes every value from the tape, SlowVars from before the reset must not be used
+func (t *SlowTape) Reset() { t.nodes = t.nodes[:0] }
+
+func (t *SlowTape) push(value *SlowPosit, parents []*SlowVar, partials ...*SlowPosit) *SlowVar {
+	n := tapeNode{value: value, partials: partials}
+	for _, p := range parents {
+		if p.tape != t {
+			panic("variables are on different tapes")
+		}
+		assertCompat(value, p.Value)
+		n.parents = append(n.parents, p.index)
+	}
+	t.nodes = append(t.nodes, n)
+	return &SlowVar{Value: value, tape: t, index: len(t.nodes) - 1}
+}
+
+// Var adds an input to the tape
+func (t *SlowTape) Var(p *SlowPosit) *SlowVar {
+	if len(t.nodes) > 0 {
+		assertCompat(t.nodes[0].value, p)
+	}
+	return t.push(p, nil)
+}
+
+func (v *SlowVar) one() *SlowPosit { return v.Value.Clone().One() }
+
+// Add returns v + x
+func (v *SlowVar) Add(x *SlowVar) *SlowVar {
+	return v.tape.push(v.Value.Add(x.Value), []*SlowVar{v, x}, v.one(), v.one())
+}
+
+// Sub returns v - x
+func (v *SlowVar) Sub(x *SlowVar) *SlowVar {
+	return v.tape.push(v.Value.Sub(x.Value), []*SlowVar{v, x}, v.one(), v.Value.Clone().NegOne())
+}
+
+// Neg returns -v
+func (v *SlowVar) Neg() *SlowVar {
+	return v.tape.push(v.Value.neg(), []*SlowVar{v}, v.Value.Clone().NegOne())
+}
+
+// Mul returns v * x, the partial derivatives are x and v so they are exact
+func (v *SlowVar) Mul(x *SlowVar) *SlowVar {
+	return v.tape.push(v.Value.Mul(x.Value), []*SlowVar{v, x}, x.Value, v.Value)
+}
+
+// Div returns v / x, the partial derivatives are 1/x and -v/x**2
+func (v *SlowVar) Div(x *SlowVar) *SlowVar {
+	dv := v.one().Div(x.Value)
+	dx := x.Value.Clone().NaR()
+	if !v.Value.IsNaR() && !x.Value.IsNaR() && !x.Value.isZero() {
+		vf, xf := v.Value.ToFloat(), x.Value.ToFloat()
+		d := quoOdd(vf, exactMul(xf, xf), v.Value.hiPrec())
+		dx = outPosit(v.Value, d.Neg(d))
+	}
+	return v.tape.push(v.Value.Div(x.Value), []*SlowVar{v, x}, dv, dx)
+}
+
+// unary pushes a function of v with the value and derivative from the SlowDual version
+func (v *SlowVar) unary(f func(*SlowDual) *SlowDual) *SlowVar {
+	z := f(&SlowDual{Val: v.Value, Der: v.one()})
+	return v.tape.push(z.Val, []*SlowVar{v}, z.Der)
+}
+
+// Sqrt returns the square root of v
+func (v *SlowVar) Sqrt() *SlowVar { return v.unary((*SlowDual).Sqrt) }
+
+// Exp returns e**v
+func (v *SlowVar) Exp() *SlowVar { return v.unary((*SlowDual).Exp) }
+
+// Log returns the natural logarithm of v
+func (v *SlowVar) Log() *SlowVar { return v.unary((*SlowDual).Log) }
+
+// Sin returns the sine of v
+func (v *SlowVar) Sin() *SlowVar { return v.unary((*SlowDual).Sin) }
+
+// Cos returns the cosine of v
+func (v *SlowVar) Cos() *SlowVar { return v.unary((*SlowDual).Cos) }
+
+// Tanh returns the hyperbolic tangent of v
+func (v *SlowVar) Tanh() *SlowVar { return v.unary((*SlowDual).Tanh) }
+
+// Atan returns the arc tangent of v
+func (v *SlowVar) Atan() *SlowVar { return v.unary((*SlowDual).Atan) }
+
+// Grad returns the derivative of out with respect to each of wrt. The derivative is NaR
+// where a NaR partial derivative was on the path from the input to out.
+func (t *SlowTape) Grad(out *SlowVar, wrt ...*SlowVar) []*SlowPosit {
+	if out.tape != t {
+		panic("variable is on a different tape")
+	}
+	acc := make([]*big.Float, out.index+1)
+	nar := make([]bool, out.index+1)
+	adjoint := make([]*SlowPosit, out.index+1)
+	acc[out.index] = big.NewFloat(1)
+	for i := out.index; i >= 0; i-- {
+		n := t.nodes[i]
+		switch {
+		case nar[i]:
+			adjoint[i] = n.value.Clone().NaR()
+		case acc[i] == nil:
+			continue
+		default:
+			adjoint[i] = outPosit(n.value, acc[i])
+		}
+		for j, p := range n.parents {
+			if nar[i] || n.partials[j].IsNaR() {
+				nar[p] = true
+				continue
+			}
+			d := exactMul(adjoint[i].ToFloat(), n.partials[j].ToFloat())
+			if acc[p] == nil {
+				acc[p] = d
+			} else {
+				acc[p] = exactAdd(acc[p], d)
+			}
+		}
+	}
+	grad := make([]*SlowPosit, len(wrt))
+	for i, w := range wrt {
+		switch {
+		case w.tape != t:
+			panic("variable is on a different tape")
+		case w.index > out.index || adjoint[w.index] == nil:
+			grad[i] = w.Value.Clone().Zero()
+		default:
+			grad[i] = adjoint[w.index]
+		}
+	}
+	return grad
+}
@@ -0,0 +1,155 @@
+package goposit_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+func p32(f float64) goposit.Posit32 {
+	sp := goposit.NewSlowPosit(32, 2)
+	sp.FromFloat(big.NewFloat(f), false)
+	return goposit.NewPosit32().SetBits(uint32(sp.Uint64()))
+}
+
+func f32(p goposit.Posit32) float64 {
+	sp := goposit.NewSlowPosit(32, 2)
+	sp.SetBits(new(big.Int).SetUint64(uint64(p.Bits())))
+	if sp.IsNaR() {
+		return math.NaN()
+	}
+	f, _ := sp.ToFloat().Float64()
+	return f
+}
+
+func f16(p goposit.Posit16) float64 {
+	sp := goposit.NewSlowPosit(16, 1)
+	sp.SetBits(new(big.Int).SetUint64(uint64(p.Bits())))
+	f, _ := sp.ToFloat().Float64()
+	return f
+}
+
+func assertNear(t *testing.T, name string, got, want float64) {
+	t.Helper()
+	// Posit32 has at least 27 fraction bits near 1
+	if math.Abs(got-want) > math.Abs(want)*1e-8 {
+		t.Errorf("%v: got %v want %v", name, got, want)
+	}
+}
+
+func TestDual(t *testing.T) {
+	d := func(x float64) goposit.Posit32Dual { return goposit.NewPosit32Dual(p32(x), goposit.Posit32One()) }
+	c := func(x float64) goposit.Posit32Dual { return goposit.NewPosit32Dual(p32(x), goposit.NewPosit32()) }
+
+	x := d(0.5)
+	f := x.Mul(x).Mul(x).Add(x.Sin())
+	assertNear(t, "x**3 + sin x", f32(f.Val()), 0.125+math.Sin(0.5))
+	assertNear(t, "d/dx x**3 + sin x", f32(f.Der()), 0.75+math.Cos(0.5))
+
+	for _, c := range []struct {
+		name     string
+		z        goposit.Posit32Dual
+		val, der float64
+	}{
+		{"1/x", c(1).Div(d(2)), 0.5, -0.25},
+		{"x/3", d(2).Div(c(3)), 2.0 / 3, 1.0 / 3},
+		{"sqrt", d(4).Sqrt(), 2, 0.25},
+		{"exp", d(1).Exp(), math.E, math.E},
+		{"log", d(4).Log(), math.Log(4), 0.25},
+		{"cos", d(1).Cos(), math.Cos(1), -math.Sin(1)},
+		{"tanh", d(0.5).Tanh(), math.Tanh(0.5), 1 - math.Tanh(0.5)*math.Tanh(0.5)},
+		{"atan", d(1).Atan(), math.Pi / 4, 0.5},
+		{"neg", d(3).Neg().Sub(c(1)), -4, -1},
+	} {
+		assertNear(t, c.name, f32(c.z.Val()), c.val)
+		assertNear(t, "d/dx "+c.name, f32(c.z.Der()), c.der)
+	}
+
+	if z := d(0).Sqrt(); f32(z.Val()) != 0 || !math.IsNaN(f32(z.Der())) {
+		t.Error("the derivative of sqrt at 0 should be NaR")
+	}
+	if z := d(-1).Log(); !math.IsNaN(f32(z.Val())) || !math.IsNaN(f32(z.Der())) {
+		t.Error("log of a negative number should be NaR")
+	}
+	if z := d(1).Div(c(0)); !math.IsNaN(f32(z.Val())) {
+		t.Error("division by zero should be NaR")
+	}
+}
+
+func TestDualRounding(t *testing.T) {
+	// the derivative a*d + b*c is rounded once, Posit16 products and their sum are exact
+	// in float64 so rounding the float64 sum gives the expected result
+	third := p16(1.0 / 3)
+	a := goposit.NewPosit16Dual(p16(3), third)
+	b := goposit.NewPosit16Dual(third, p16(-3))
+	tf := f16(third)
+	if z := a.Mul(b); z.Der().Bits() != p16(3*-3+tf*tf).Bits() {
+		t.Errorf("unexpected derivative %x", z.Der().Bits())
+	}
+
+	// the derivatives of Log and Div are quotients of posits, rounded once from the exact value
+	sp := goposit.NewSlowPosit(16, 1)
+	rat := func(p goposit.Posit16) *big.Rat {
+		sp.SetBits(new(big.Int).SetUint64(uint64(p.Bits())))
+		return sp.Rat()
+	}
+	round := func(r *big.Rat) uint16 { return uint16(sp.FromRat(r).Uint64()) }
+	x, d := p16(7), p16(0.1)
+	if z := goposit.NewPosit16Dual(x, d).Log(); z.Der().Bits() != round(new(big.Rat).Quo(rat(d), rat(x))) {
+		t.Errorf("unexpected derivative of log %x", z.Der().Bits())
+	}
+	// d/dx 1/x = -1/x**2
+	one16 := goposit.NewPosit16Dual(goposit.Posit16One(), goposit.NewPosit16())
+	want := new(big.Rat).Quo(big.NewRat(-1, 1), new(big.Rat).Mul(rat(x), rat(x)))
+	if z := one16.Div(goposit.NewPosit16Dual(x, goposit.Posit16One())); z.Der().Bits() != round(want) {
+		t.Errorf("unexpected derivative of 1/x %x", z.Der().Bits())
+	}
+
+	one := goposit.Posit64One()
+	if z := goposit.NewPosit64Dual(one, one).Exp(); z.Val().Bits() != goposit.Posit64E().Bits() ||
+		z.Der().Bits() != goposit.Posit64E().Bits() {
+		t.Error("exp(1) should be e correctly rounded")
+	}
+}
+
+func TestTape(t *testing.T) {
+	tape := goposit.NewPosit32Tape()
+	x, y := tape.Var(p32(0.5)), tape.Var(p32(0.5))
+	one, hundred := tape.Var(goposit.Posit32One()), tape.Var(p32(100))
+
+	// Rosenbrock (1-x)**2 + 100(y-x**2)**2
+	a := one.Sub(x)
+	b := y.Sub(x.Mul(x))
+	f := a.Mul(a).Add(hundred.Mul(b).Mul(b))
+	if f32(f.Value()) != 6.5 {
+		t.Errorf("f should be 6.5 got %v", f32(f.Value()))
+	}
+	g := tape.Grad(f, x, y, one)
+	if f32(g[0]) != -51 || f32(g[1]) != 50 || f32(g[2]) != 1 {
+		t.Errorf("gradient should be [-51 50 1] got [%v %v %v]", f32(g[0]), f32(g[1]), f32(g[2]))
+	}
+
+	// the tape agrees with dual numbers
+	h := x.Mul(y).Add(x.Sin()).Div(y.Exp()).Tanh()
+	dx := goposit.NewPosit32Dual(p32(0.5), goposit.Posit32One())
+	dy := goposit.NewPosit32Dual(p32(0.5), goposit.NewPosit32())
+	dh := dx.Mul(dy).Add(dx.Sin()).Div(dy.Exp()).Tanh()
+	if h.Value().Bits() != dh.Val().Bits() {
+		t.Errorf("values differ %v %v", f32(h.Value()), f32(dh.Val()))
+	}
+	assertNear(t, "dh/dx", f32(tape.Grad(h, x)[0]), f32(dh.Der()))
+
+	unused := tape.Var(p32(7))
+	if g := tape.Grad(f, unused); f32(g[0]) != 0 {
+		t.Error("an input which is not used should have a zero derivative")
+	}
+	s := tape.Var(goposit.NewPosit32())
+	if g := tape.Grad(s.Sqrt().Add(x), s, x); !math.IsNaN(f32(g[0])) || f32(g[1]) != 1 {
+		t.Errorf("NaR should only reach the inputs behind it, got %v %v", f32(g[0]), f32(g[1]))
+	}
+	if tape.Reset(); tape.Len() != 0 {
+		t.Error("Reset should empty the tape")
+	}
+}
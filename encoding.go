@@ -0,0 +1,307 @@
+package goposit
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// JSONFormat selects how MarshalJSON writes a posit, UnmarshalJSON accepts every format
+type JSONFormat int
+
+const (
+	// JSONDecimal writes the shortest decimal string which converts back to the same posit,
+	// for example "3.140625", NaR is written as "NaR".
+	JSONDecimal JSONFormat = iota
+
+	// JSONHex writes the bits of the posit as a hex string, for example "0x6487"
+	JSONHex
+)
+
+// DefaultJSONFormat is the format used by MarshalJSON, it is only a fallback for posits
+// which do not choose their own format with a wrapper such as Posit16JSONHex.
+var DefaultJSONFormat = JSONDecimal
+
+// ErrEncoding is returned when decoding a posit from binary, text or JSON fails
+var ErrEncoding = errors.New("invalid posit encoding")
+
+// maxEncodedBits is the largest posit which will be decoded, so that untrusted input can not
+// make a posit of any size it likes
+const maxEncodedBits = 1 << 16
+
+// maxEncodedEs is the largest es which will be decoded, it keeps the scale of every posit,
+// at most nbits<<es, within the int32 exponent of a big.Float.
+const maxEncodedEs = 14
+
+// validSize checks nbits and es read from an encoded posit
+func validSize(nbits, es uint64) bool {
+	return nbits <= maxEncodedBits && es <= maxEncodedEs && nbits > es+3
+}
+
+// isLittle checks the byte order by decoding a known value, this works for any order
+func isLittle(order binary.ByteOrder) bool {
+	return order.Uint16([]byte{1, 0}) == 1
+}
+
+// bytes outputs the bits of the posit in the smallest number of whole bytes
+func (p *SlowPosit) bytes(order binary.ByteOrder) []byte {
+	out := p.Bits.FillBytes(make([]byte, (p.nbits+7)/8))
+	if isLittle(order) {
+		for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+			out[i], out[j] = out[j], out[i]
+		}
+	}
+	return out
+}
+
+// setBytes sets the bits of the posit from the output of bytes
+func (p *SlowPosit) setBytes(data []byte, order binary.ByteOrder) error {
+	if len(data) != int((p.nbits+7)/8) {
+		return fmt.Errorf("%w: %d bytes for a %d bit posit", ErrEncoding, len(data), p.nbits)
+	}
+	be := append([]byte(nil), data...)
+	if isLittle(order) {
+		for i, j := 0, len(be)-1; i < j; i, j = i+1, j-1 {
+			be[i], be[j] = be[j], be[i]
+		}
+	}
+	bits := new(big.Int).SetBytes(be)
+	if bits.BitLen() > int(p.nbits) {
+		return fmt.Errorf("%w: value does not fit in %d bits", ErrEncoding, p.nbits)
+	}
+	p.Bits = bits
+	return nil
+}
+
+// hex returns the bits of the posit as 0x followed by one digit per 4 bits
+func (p *SlowPosit) hex() string {
+	return fmt.Sprintf("0x%0*x", (p.nbits+3)/4, p.Bits)
+}
+
+// decimal returns the shortest decimal string which converts back to the same posit
+func (p *SlowPosit) decimal() string {
+	if p.IsNaR() {
+		return "NaR"
+	}
+	f := p.ToFloat()
+	for digits := 1; ; digits++ {
+		s := f.Text('g', digits)
+		r, _ := new(big.Rat).SetString(s)
+		if p.Clone().fromRat(r).Bits.Cmp(p.Bits) == 0 {
+			return s
+		}
+	}
+}
+
+// fromRat sets the posit to the value of r rounded to nearest even, r is first truncated to
+// a float with enough bits and then rounded to odd so that it is rounded only once.
+func (p *SlowPosit) fromRat(r *big.Rat) *SlowPosit {
+	f := new(big.Float).SetPrec(p.hiPrec()).SetMode(big.ToZero).SetRat(r)
+	p.FromFloat(roundOdd(f, f.Acc() != big.Exact), false)
+	return p
+}
+
+// parse sets the posit from a decimal number, a hex string of bits beginning with 0x or NaR
+func (p *SlowPosit) parse(s string) error {
+	s = strings.TrimSpace(s)
+	if strings.EqualFold(s, "NaR") {
+		p.NaR()
+		return nil
+	}
+	if len(s) > 2 && (s[:2] == "0x" || s[:2] == "0X") {
+		bits, ok := new(big.Int).SetString(s[2:], 16)
+		if !ok || bits.BitLen() > int(p.nbits) {
+			return fmt.Errorf("%w: %q is not a %d bit hex value", ErrEncoding, s, p.nbits)
+		}
+		p.Bits = bits
+		return nil
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return fmt.Errorf("%w: %q is not a number", ErrEncoding, s)
+	}
+	p.fromRat(r)
+	return nil
+}
+
+// marshalJSON writes the posit as a JSON string in the format f
+func (p *SlowPosit) marshalJSON(f JSONFormat) ([]byte, error) {
+	switch f {
+	case JSONDecimal:
+		return []byte(strconv.Quote(p.decimal())), nil
+	case JSONHex:
+		return []byte(strconv.Quote(p.hex())), nil
+	}
+	return nil, fmt.Errorf("unknown JSONFormat %d", f)
+}
+
+// unmarshalJSON sets the posit from a JSON string in any format or from a JSON number
+func (p *SlowPosit) unmarshalJSON(data []byte) error {
+	s := string(data)
+	if len(s) > 0 && s[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return fmt.Errorf("%w: %v", ErrEncoding, err)
+		}
+	}
+	return p.parse(s)
+}
+
+// MarshalBinary outputs nbits and es as uvarints followed by the bits of the posit in
+// little endian order.
+func (p *SlowPosit) MarshalBinary() ([]byte, error) {
+	return p.MarshalBinaryOrder(binary.LittleEndian), nil
+}
+
+// MarshalBinaryOrder is the same as MarshalBinary but the bits are written in the
+// specified order.
+func (p *SlowPosit) MarshalBinaryOrder(order binary.ByteOrder) []byte {
+	out := binary.AppendUvarint(nil, uint64(p.nbits))
+	out = binary.AppendUvarint(out, uint64(p.es))
+	return append(out, p.bytes(order)...)
+}
+
+// UnmarshalBinary sets the posit, including nbits and es, from the output of MarshalBinary
+func (p *SlowPosit) UnmarshalBinary(data []byte) error {
+	return p.UnmarshalBinaryOrder(data, binary.LittleEndian)
+}
+
+// UnmarshalBinaryOrder sets the posit from the output of MarshalBinaryOrder
+func (p *SlowPosit) UnmarshalBinaryOrder(data []byte, order binary.ByteOrder) error {
+	nbits, n := binary.Uvarint(data)
+	if n <= 0 {
+		return fmt.Errorf("%w: missing nbits", ErrEncoding)
+	}
+	data = data[n:]
+	es, n := binary.Uvarint(data)
+	if n <= 0 {
+		return fmt.Errorf("%w: missing es", ErrEncoding)
+	}
+	if !validSize(nbits, es) {
+		return fmt.Errorf("%w: nbits %d and es %d are not a valid posit size", ErrEncoding, nbits, es)
+	}
+	out := NewSlowPosit(uint(nbits), uint(es))
+	if err := out.setBytes(data[n:], order); err != nil {
+		return err
+	}
+	*p = *out
+	return nil
+}
+
+// MarshalText outputs the posit as <nbits>,<es>:<value> where the value is the shortest
+// decimal which converts back to the same posit, for example 16,1:3.140625
+func (p *SlowPosit) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%d,%d:%s", p.nbits, p.es, p.decimal())), nil
+}
+
+// UnmarshalText sets the posit, including nbits and es, from the output of MarshalText,
+// the value may also be a hex string of bits such as 0x6487.
+func (p *SlowPosit) UnmarshalText(text []byte) error {
+	var nbits, es uint
+	size, value, ok := strings.Cut(string(text), ":")
+	if !ok {
+		return fmt.Errorf("%w: %q has no size", ErrEncoding, text)
+	}
+	if _, err := fmt.Sscanf(size, "%d,%d", &nbits, &es); err != nil || !validSize(uint64(nbits), uint64(es)) {
+		return fmt.Errorf("%w: %q is not a valid posit size", ErrEncoding, size)
+	}
+	out := NewSlowPosit(nbits, es)
+	if err := out.parse(value); err != nil {
+		return err
+	}
+	*p = *out
+	return nil
+}
+
+type slowPositJSON struct {
+	Nbits uint            `json:"nbits"`
+	Es    uint            `json:"es"`
+	Value json.RawMessage `json:"value"`
+}
+
+// MarshalJSON outputs the posit as an object with nbits, es and the value in the format
+// DefaultJSONFormat.
+func (p *SlowPosit) MarshalJSON() ([]byte, error) {
+	return p.MarshalJSONFormat(DefaultJSONFormat)
+}
+
+// MarshalJSONFormat is the same as MarshalJSON but the value is written in the format f
+func (p *SlowPosit) MarshalJSONFormat(f JSONFormat) ([]byte, error) {
+	v, err := p.marshalJSON(f)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(slowPositJSON{Nbits: p.nbits, Es: p.es, Value: v})
+}
+
+// UnmarshalJSON sets the posit, including nbits and es, from the output of MarshalJSON
+func (p *SlowPosit) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var j slowPositJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return fmt.Errorf("%w: %v", ErrEncoding, err)
+	}
+	if !validSize(uint64(j.Nbits), uint64(j.Es)) || j.Value == nil {
+		return fmt.Errorf("%w: nbits %d and es %d are not a valid posit size", ErrEncoding, j.Nbits, j.Es)
+	}
+	out := NewSlowPosit(j.Nbits, j.Es)
+	if err := out.unmarshalJSON(j.Value); err != nil {
+		return err
+	}
+	*p = *out
+	return nil
+}
+
+// SlowPositJSONHex is a SlowPosit which MarshalJSON always writes in the JSONHex format,
+// whatever DefaultJSONFormat is, use it for struct fields which need a fixed format.
+type SlowPositJSONHex struct{ *SlowPosit }
+
+// MarshalJSON outputs the posit as MarshalJSONFormat(JSONHex) does
+func (p SlowPositJSONHex) MarshalJSON() ([]byte, error) {
+	return marshalJSONField(p.SlowPosit, JSONHex)
+}
+
+// UnmarshalJSON sets the posit from the output of MarshalJSON in any format
+func (p *SlowPositJSONHex) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONField(&p.SlowPosit, data)
+}
+
+// SlowPositJSONDecimal is a SlowPosit which MarshalJSON always writes in the JSONDecimal
+// format, whatever DefaultJSONFormat is, use it for struct fields which need a fixed format.
+type SlowPositJSONDecimal struct{ *SlowPosit }
+
+// MarshalJSON outputs the posit as MarshalJSONFormat(JSONDecimal) does
+func (p SlowPositJSONDecimal) MarshalJSON() ([]byte, error) {
+	return marshalJSONField(p.SlowPosit, JSONDecimal)
+}
+
+// UnmarshalJSON sets the posit from the output of MarshalJSON in any format
+func (p *SlowPositJSONDecimal) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONField(&p.SlowPosit, data)
+}
+
+// marshalJSONField writes a posit which may be nil in the format f
+func marshalJSONField(p *SlowPosit, f JSONFormat) ([]byte, error) {
+	if p == nil {
+		return []byte("null"), nil
+	}
+	return p.MarshalJSONFormat(f)
+}
+
+// unmarshalJSONField sets *p from JSON, allocating a posit if *p is nil
+func unmarshalJSONField(p **SlowPosit, data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	out := new(SlowPosit)
+	if err := out.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*p = out
+	return nil
+}
@@ -0,0 +1,178 @@
+package goposit_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+// Every Posit16 should survive a trip through text and the shortest decimal is used
+func TestTextRoundTrip(t *testing.T) {
+	p := goposit.NewPosit16()
+	for i := 0; i < 1<<16; i++ {
+		x := p.SetBits(uint16(i))
+		text, _ := x.MarshalText()
+		var y goposit.Posit16
+		if err := y.UnmarshalText(text); err != nil || y.Bits() != x.Bits() {
+			t.Fatalf("%x: %s decoded to %x (%v)", i, text, y.Bits(), err)
+		}
+	}
+	for bits, expect := range map[uint16]string{0x4000: "1", 0xc000: "-1", 0x8000: "NaR", 0: "0", 0x4800: "1.5"} {
+		if text, _ := p.SetBits(bits).MarshalText(); string(text) != expect {
+			t.Errorf("%x: expected %v got %s", bits, expect, text)
+		}
+	}
+	// 1 + 2**-13 is half way between 1 and the next Posit16 so it rounds to even
+	var y goposit.Posit16
+	if err := y.UnmarshalText([]byte("1.0001220703125")); err != nil || y.Bits() != 0x4000 {
+		t.Errorf("half way should round to even, got %x (%v)", y.Bits(), err)
+	}
+	if err := y.UnmarshalText([]byte("1.0001220703126")); err != nil || y.Bits() != 0x4001 {
+		t.Errorf("just past half way should round up, got %x (%v)", y.Bits(), err)
+	}
+	if err := y.UnmarshalText([]byte("one")); !errors.Is(err, goposit.ErrEncoding) {
+		t.Errorf("expected ErrEncoding, got %v", err)
+	}
+}
+
+func TestBinary(t *testing.T) {
+	x := goposit.NewPosit32().SetBits(0x12345678)
+	b, _ := x.MarshalBinary()
+	if !bytes.Equal(b, []byte{0x78, 0x56, 0x34, 0x12}) {
+		t.Errorf("MarshalBinary should be little endian, got %x", b)
+	}
+	if b := x.MarshalBinaryOrder(binary.BigEndian); !bytes.Equal(b, []byte{0x12, 0x34, 0x56, 0x78}) {
+		t.Errorf("MarshalBinaryOrder(BigEndian) got %x", b)
+	}
+	var y goposit.Posit32
+	if err := y.UnmarshalBinaryOrder([]byte{0x12, 0x34, 0x56, 0x78}, binary.BigEndian); err != nil || y.Bits() != 0x12345678 {
+		t.Errorf("UnmarshalBinaryOrder got %x (%v)", y.Bits(), err)
+	}
+	if err := y.UnmarshalBinary([]byte{1, 2}); !errors.Is(err, goposit.ErrEncoding) {
+		t.Errorf("2 bytes should not decode as a Posit32")
+	}
+
+	sp := goposit.NewSlowPosit(12, 1)
+	sp.SetBits(big.NewInt(0x9ab))
+	b, _ = sp.MarshalBinary()
+	var sp2 goposit.SlowPosit
+	if err := sp2.UnmarshalBinary(b); err != nil || sp2.Nbits() != 12 || sp2.Es() != 1 || sp2.Uint64() != 0x9ab {
+		t.Errorf("SlowPosit binary round trip got %v (%v)", sp2.RawHex(), err)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type result struct {
+		A goposit.Posit16
+		B goposit.Posit64
+		C goposit.Posit8
+		D *goposit.SlowPosit
+	}
+	d := goposit.NewSlowPosit(10, 2)
+	d.FromFloat(big.NewFloat(-0.75), false)
+	in := result{A: goposit.Posit16Pi(), B: goposit.Posit64NaR(), D: d}
+	out, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := `{"A":"3.1416","B":"NaR","C":"0","D":{"nbits":10,"es":2,"value":"-0.75"}}`
+	if string(out) != expect {
+		t.Errorf("expected %v got %s", expect, out)
+	}
+	var back result
+	if err := json.Unmarshal(out, &back); err != nil {
+		t.Fatal(err)
+	}
+	if back.A.Bits() != in.A.Bits() || back.B.Bits() != in.B.Bits() || back.C.Bits() != 0 ||
+		back.D.Nbits() != 10 || back.D.Uint64() != d.Uint64() {
+		t.Errorf("JSON round trip failed: %s", out)
+	}
+
+	hex, _ := goposit.Posit16Pi().MarshalJSONFormat(goposit.JSONHex)
+	if string(hex) != `"0x5922"` {
+		t.Errorf("expected \"0x5922\" got %s", hex)
+	}
+	var a goposit.Posit16
+	if err := json.Unmarshal(hex, &a); err != nil || a.Bits() != 0x5922 {
+		t.Errorf("hex did not decode, got %x (%v)", a.Bits(), err)
+	}
+	if err := json.Unmarshal([]byte("2.5"), &a); err != nil || a.Bits() != 0x5400 {
+		t.Errorf("JSON numbers should decode, got %x (%v)", a.Bits(), err)
+	}
+}
+
+// The wrapper types choose the JSON format per field, whatever DefaultJSONFormat is
+func TestJSONFormatFields(t *testing.T) {
+	type result struct {
+		A goposit.Posit16JSONHex
+		B goposit.Posit16JSONDecimal
+		C goposit.SlowPositJSONHex
+		D goposit.SlowPositJSONDecimal
+	}
+	c := goposit.NewSlowPosit(10, 2)
+	c.FromFloat(big.NewFloat(-0.75), false)
+	in := result{
+		A: goposit.Posit16JSONHex{Posit16: goposit.Posit16Pi()},
+		B: goposit.Posit16JSONDecimal{Posit16: goposit.Posit16Pi()},
+		C: goposit.SlowPositJSONHex{SlowPosit: c},
+	}
+	out, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := `{"A":"0x5922","B":"3.1416","C":{"nbits":10,"es":2,"value":"0x310"},"D":null}`
+	if string(out) != expect {
+		t.Errorf("expected %v got %s", expect, out)
+	}
+	var back result
+	if err := json.Unmarshal(out, &back); err != nil {
+		t.Fatal(err)
+	}
+	if back.A.Bits() != 0x5922 || back.B.Bits() != 0x5922 || back.C.Uint64() != c.Uint64() ||
+		back.D.SlowPosit != nil {
+		t.Errorf("JSON round trip failed: %s", out)
+	}
+}
+
+// Every decoder must refuse a posit too large to allocate safely
+func TestDecodeSizeLimit(t *testing.T) {
+	var sp goposit.SlowPosit
+	if err := sp.UnmarshalText([]byte("100000000,1:1.5")); !errors.Is(err, goposit.ErrEncoding) {
+		t.Errorf("UnmarshalText should refuse 100000000 bits, got %v", err)
+	}
+	if err := sp.UnmarshalJSON([]byte(`{"nbits":100000000,"es":1,"value":"1.5"}`)); !errors.Is(err, goposit.ErrEncoding) {
+		t.Errorf("UnmarshalJSON should refuse 100000000 bits, got %v", err)
+	}
+	if err := sp.UnmarshalText([]byte("8,18446744073709551615:1")); !errors.Is(err, goposit.ErrEncoding) {
+		t.Errorf("UnmarshalText should refuse an es which overflows, got %v", err)
+	}
+	if err := sp.UnmarshalText([]byte("65536,1:1.5")); err != nil || sp.Nbits() != 65536 {
+		t.Errorf("UnmarshalText should accept 65536 bits, got %v", err)
+	}
+
+	// an es which is allowed by nbits but so large that 1<<es is meaningless
+	for _, size := range [][2]uint64{{70, 64}, {100, 70}, {64, 15}, {2, 10}} {
+		text := fmt.Sprintf("%d,%d:1.5", size[0], size[1])
+		if err := sp.UnmarshalText([]byte(text)); !errors.Is(err, goposit.ErrEncoding) {
+			t.Errorf("UnmarshalText(%v) should fail, got %v", text, err)
+		}
+		js := fmt.Sprintf(`{"nbits":%d,"es":%d,"value":"1.5"}`, size[0], size[1])
+		if err := sp.UnmarshalJSON([]byte(js)); !errors.Is(err, goposit.ErrEncoding) {
+			t.Errorf("UnmarshalJSON(%v) should fail, got %v", js, err)
+		}
+		bin := binary.AppendUvarint(binary.AppendUvarint(nil, size[0]), size[1])
+		bin = append(bin, make([]byte, (size[0]+7)/8)...)
+		if err := sp.UnmarshalBinary(bin); !errors.Is(err, goposit.ErrEncoding) {
+			t.Errorf("UnmarshalBinary of %v bits es %v should fail, got %v", size[0], size[1], err)
+		}
+	}
+	if err := sp.UnmarshalText([]byte("20,14:1.5")); err != nil || sp.Es() != 14 {
+		t.Errorf("UnmarshalText should accept es 14, got %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package goposit
+
+import (
+	"math/big"
+)
+
+// SlowFields contains the bit fields of a posit. Negative posits are decoded from their
+// two's complement, exactly the way the value is computed, so the fields describe the
+// magnitude and Sign says whether it was negative. The value of a posit is
+// (-1)**Sign * 2**Scale * (1 + Fraction / 2**FractionBits).
+type SlowFields struct {
+	// NaR is set if the posit is Not a Real, then all other fields are zero
+	NaR bool
+
+	// Zero is set if the posit is zero, then all other fields are zero
+	Zero bool
+
+	// Sign is true if the posit is negative
+	Sign bool
+
+	// RegimeK is the value of the regime, the number of identical bits minus one if they
+	// are ones or the negative number of identical bits if they are zeros.
+	RegimeK int
+
+	// RegimeLen is the number of bits in the regime including the terminating bit, if
+	// the regime runs to the end of the posit there is no terminating bit.
+	RegimeLen int
+
+	// ExponentBits is the exponent field, if the exponent is cut off by the end of the
+	// posit then the missing low bits are taken to be zero.
+	ExponentBits uint
+
+	// ExponentLen is the number of exponent bits which are actually present
+	ExponentLen int
+
+	// Fraction is the fraction field without the hidden bit
+	Fraction *big.Int
+
+	// FractionBits is the width of the fraction field
+	FractionBits int
+
+	// Scale is the power of two which the posit represents, RegimeK * 2**es + ExponentBits
+	Scale int
+}
+
+// Decode breaks the posit down into its bit fields
+func (p *SlowPosit) Decode() *SlowFields {
+	out := &SlowFields{Fraction: big.NewInt(0)}
+	if p.IsNaR() {
+		out.NaR = true
+		return out
+	}
+	if p.Bits.Sign() == 0 {
+		out.Zero = true
+		return out
+	}
+	b := new(big.Int).Set(p.Bits)
+	if b.Bit(p.signBitIdx()) == 1 {
+		out.Sign = true
+		negate(b, p.nbits)
+	}
+
+	idx := p.regBitIdx()
+	first := b.Bit(idx)
+	run := 0
+	for ; idx >= 0 && b.Bit(idx) == first; idx-- {
+		run++
+	}
+	out.RegimeLen = run
+	if idx >= 0 {
+		// skip the terminating bit
+		out.RegimeLen++
+		idx--
+	}
+	if first == 1 {
+		out.RegimeK = run - 1
+	} else {
+		out.RegimeK = -run
+	}
+
+	// idx is now the index of the top exponent bit, there are idx+1 bits remaining
+	remaining := idx + 1
+	out.ExponentLen = int(p.es)
+	if remaining < out.ExponentLen {
+		out.ExponentLen = remaining
+	}
+	for i := 0; i < out.ExponentLen; i++ {
+		out.ExponentBits = (out.ExponentBits << 1) | b.Bit(idx-i)
+	}
+	out.ExponentBits <<= p.es - uint(out.ExponentLen)
+
+	out.FractionBits = remaining - out.ExponentLen
+	mask := new(big.Int).Lsh(bigi1, uint(out.FractionBits))
+	mask.Sub(mask, bigi1)
+	out.Fraction.And(b, mask)
+
+	out.Scale = out.RegimeK*(1<<p.es) + int(out.ExponentBits)
+	return out
+}
+
+// Encode creates a new posit from bit fields, the value is computed from Sign, RegimeK,
+// ExponentBits, Fraction and FractionBits and then rounded to nearest even, so fields
+// with more fraction bits than will fit are allowed and a nil Fraction is zero. RegimeLen,
+// ExponentLen and Scale are ignored. p.Encode() outputs a new posit z, p is not altered
+func (p *SlowPosit) Encode(f *SlowFields) *SlowPosit {
+	if f.NaR {
+		return p.Clone().NaR()
+	}
+	if f.Zero {
+		return p.Clone().Zero()
+	}
+	if f.ExponentBits >= 1<<p.es {
+		panic("exponent does not fit in es bits")
+	}
+	fraction := f.Fraction
+	if fraction == nil {
+		fraction = new(big.Int)
+	}
+	if fraction.Sign() < 0 || fraction.BitLen() > f.FractionBits {
+		panic("fraction does not fit in FractionBits")
+	}
+	frac := new(big.Float).SetInt(fraction)
+	frac.SetMantExp(frac, -f.FractionBits)
+	v := exactAdd(frac, bigf1)
+	v.SetMantExp(v, f.RegimeK*(1<<p.es)+int(f.ExponentBits))
+	if f.Sign {
+		v.Neg(v)
+	}
+	return outPosit(p, v)
+}
+
+// PositFields contains the bit fields of a Posit8, Posit16, Posit32 or Posit64, the
+// fields mean the same as in SlowFields.
+type PositFields struct {
+	NaR          bool
+	Zero         bool
+	Sign         bool
+	RegimeK      int
+	RegimeLen    int
+	ExponentBits uint
+	ExponentLen  int
+	Fraction     uint64
+	FractionBits int
+	Scale        int
+}
+
+func (f *SlowFields) positFields() PositFields {
+	return PositFields{
+		NaR:          f.NaR,
+		Zero:         f.Zero,
+		Sign:         f.Sign,
+		RegimeK:      f.RegimeK,
+		RegimeLen:    f.RegimeLen,
+		ExponentBits: f.ExponentBits,
+		ExponentLen:  f.ExponentLen,
+		Fraction:     f.Fraction.Uint64(),
+		FractionBits: f.FractionBits,
+		Scale:        f.Scale,
+	}
+}
+
+func (f PositFields) slowFields() *SlowFields {
+	return &SlowFields{
+		NaR:          f.NaR,
+		Zero:         f.Zero,
+		Sign:         f.Sign,
+		RegimeK:      f.RegimeK,
+		RegimeLen:    f.RegimeLen,
+		ExponentBits: f.ExponentBits,
+		ExponentLen:  f.ExponentLen,
+		Fraction:     new(big.Int).SetUint64(f.Fraction),
+		FractionBits: f.FractionBits,
+		Scale:        f.Scale,
+	}
+}
@@ -0,0 +1,68 @@
+package goposit_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+func TestDecodeExample(t *testing.T) {
+	// 0 110 1100 is 2**1 * (1 + 12/16) = 3.5
+	f := goposit.NewPosit8().SetBits(0x6c).Decode()
+	expect := goposit.PositFields{RegimeK: 1, RegimeLen: 3, Fraction: 12, FractionBits: 4, Scale: 1}
+	if f != expect {
+		t.Errorf("Decode(0x6c) = %+v expected %+v", f, expect)
+	}
+
+	// 1 0001 is the two's complement of 0 1111 which has a regime of 4 ones
+	f = goposit.NewPosit8().SetBits(0x88).Decode()
+	expect = goposit.PositFields{Sign: true, RegimeK: 3, RegimeLen: 5, Fraction: 0, FractionBits: 2, Scale: 3}
+	if f != expect {
+		t.Errorf("Decode(0x88) = %+v expected %+v", f, expect)
+	}
+
+	// 1.5 + 1/64 does not fit in a Posit8 near 1 (5 fraction bits) so it rounds to even
+	in := goposit.PositFields{Fraction: 0x21, FractionBits: 6}
+	if out := goposit.NewPosit8().Encode(in); out.Bits() != 0x50 {
+		t.Errorf("Encode should round 1.515625 to 1.5, got [%x]", out.Bits())
+	}
+
+	// a nil Fraction is zero, -4 is the negation of 0 110 0 000
+	sf := &goposit.SlowFields{Sign: true, RegimeK: 1}
+	if out := goposit.NewSlowPosit(8, 1).Encode(sf); out.Bits.Int64() != 0xa0 {
+		t.Errorf("Encode with a nil Fraction should give -4, got [%x]", out.Bits)
+	}
+}
+
+// Every Posit16 must decode to fields which give the same value and re-encode the same
+func TestDecodeEncode(t *testing.T) {
+	sp := goposit.NewSlowPosit(16, 1)
+	for i := 0; i < 1<<16; i++ {
+		bits := big.NewInt(int64(i))
+		sp.SetBits(bits)
+		f := sp.Decode()
+		if f.NaR || f.Zero {
+			if f.NaR != sp.IsNaR() || f.Zero != (i == 0) {
+				t.Errorf("[%x] decoded as NaR=%v Zero=%v", i, f.NaR, f.Zero)
+			}
+			continue
+		}
+		if 1+f.RegimeLen+f.ExponentLen+f.FractionBits != 16 {
+			t.Errorf("[%x] fields do not add up to 16 bits %+v", i, f)
+		}
+		v := new(big.Float).SetInt(f.Fraction)
+		v.SetMantExp(v, -f.FractionBits)
+		v.Add(v, big.NewFloat(1))
+		v.SetMantExp(v, f.Scale)
+		if f.Sign {
+			v.Neg(v)
+		}
+		if v.Cmp(sp.ToFloat()) != 0 {
+			t.Errorf("[%x] decodes to %v but the value is %v", i, v, sp.ToFloat())
+		}
+		if out := sp.Encode(f); out.Bits.Cmp(bits) != 0 {
+			t.Errorf("[%x] encodes back to [%x]", i, out.Bits)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package goposit
+
+// Fixed point numbers in Qm.n format are integers whose low n bits are the fraction, so
+// the value of a fixed point number v with n fraction bits is v * 2**-n.
+
+// FromFixed creates a new posit which is set to the value of the fixed point number v with
+// fracBits fraction bits, rounded to nearest even. Numbers larger than maxpos saturate to
+// maxpos and numbers which are not zero but smaller than minpos become minpos. The result
+// is true if the number could be converted without rounding.
+// p.FromFixed() outputs a new posit z, p is not altered
+func (p *SlowPosit) FromFixed(v int64, fracBits uint) (*SlowPosit, bool) {
+	f := newBig(64).SetInt64(v)
+	Shf(f, -int(fracBits))
+	out := NewSlowPosit(p.nbits, p.es)
+	exact := out.FromFloat(f, false)
+	return out, exact
+}
+
+// toFixed converts the posit to a fixed point number of the given number of bits with
+// fracBits fraction bits, rounding in the given mode. If it is out of range then the result
+// saturates and ok is false, NaR gives zero and false.
+func (p *SlowPosit) toFixed(mode RoundingMode, fracBits, bits uint) (v int64, ok bool) {
+	if p.IsNaR() {
+		return 0, false
+	}
+	f := p.ToFloat()
+	Shf(f, int(fracBits))
+	return saturateInt(roundInt(f, mode), bits)
+}
+
+// ToFixed64 converts the posit to a 64 bit fixed point number with fracBits fraction bits,
+// rounding in the given mode. If the result is out of range it saturates to the minimum or
+// maximum and ok is false. NaR gives zero and false.
+func (p *SlowPosit) ToFixed64(fracBits uint, mode RoundingMode) (v int64, ok bool) {
+	return p.toFixed(mode, fracBits, 64)
+}
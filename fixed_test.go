@@ -0,0 +1,93 @@
+package goposit_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+func TestToFixed(t *testing.T) {
+	// Q7.8: 1.375 is 0x160
+	if v, ok := p16(1.375).ToFixed16(8, goposit.RoundNearestEven); v != 0x160 || !ok {
+		t.Errorf("1.375 in Q7.8 should be 0x160, got %x %v", v, ok)
+	}
+	// 1/3 in Q15 is 10922.67
+	third := p32(1.0 / 3)
+	for _, c := range []struct {
+		mode goposit.RoundingMode
+		want int16
+	}{
+		{goposit.RoundNearestEven, 10923},
+		{goposit.RoundTruncate, 10922},
+		{goposit.RoundFloor, 10922},
+		{goposit.RoundCeil, 10923},
+	} {
+		if v, ok := third.ToFixed16(15, c.mode); v != c.want || !ok {
+			t.Errorf("1/3 in Q15 mode %v: got %v want %v", c.mode, v, c.want)
+		}
+	}
+	x := third.Sub(goposit.Posit32One())
+	if v, _ := x.ToFixed32(30, goposit.RoundFloor); float64(v) != math.Floor(f32(x)*(1<<30)) {
+		t.Errorf("-2/3 in Q1.30 rounded down, got %v", v)
+	}
+
+	// 2 does not fit in Q0.15
+	if v, ok := p16(2).ToFixed16(15, goposit.RoundTruncate); v != math.MaxInt16 || ok {
+		t.Errorf("2 should saturate in Q15, got %v %v", v, ok)
+	}
+	if v, ok := p16(-1).ToFixed16(15, goposit.RoundTruncate); v != math.MinInt16 || !ok {
+		t.Errorf("-1 is the minimum in Q15, got %v %v", v, ok)
+	}
+	if v, ok := goposit.Posit64NaR().ToFixed64(32, goposit.RoundNearestEven); v != 0 || ok {
+		t.Errorf("NaR should not convert, got %v %v", v, ok)
+	}
+}
+
+func TestFromFixed(t *testing.T) {
+	p := goposit.NewPosit32()
+	if x, exact := p.FromFixed16(-0x160, 8); f32(x) != -1.375 || !exact {
+		t.Errorf("-0x160 in Q7.8 should be -1.375, got %v %v", f32(x), exact)
+	}
+	// a Posit8 has too few fraction bits for 0x7fff
+	if x, exact := goposit.NewPosit8().FromFixed16(0x7fff, 15); x.Bits() != goposit.Posit8One().Bits() || exact {
+		t.Errorf("0x7fff in Q15 should round to 1 in Posit8, got %x %v", x.Bits(), exact)
+	}
+	if x, exact := p.FromFixed64(0, 63); x.Bits() != 0 || !exact {
+		t.Error("zero should be zero")
+	}
+
+	// every Q7.8 number survives a round trip through Posit32
+	for i := math.MinInt16; i <= math.MaxInt16; i++ {
+		x, exact := p.FromFixed16(int16(i), 8)
+		if v, ok := x.ToFixed16(8, goposit.RoundTruncate); !exact || !ok || v != int16(i) {
+			t.Fatalf("%x did not round trip, got %x", i, v)
+		}
+	}
+}
+
+func TestFixedSlice(t *testing.T) {
+	src := []goposit.Posit16{p16(0.5), p16(-0.25), p16(200), goposit.Posit16NaR()}
+	dst := make([]int32, len(src))
+	if bad := goposit.Posit16SliceToFixed32(dst, src, 24, goposit.RoundNearestEven); bad != 2 {
+		t.Errorf("200 and NaR should not convert, got %v", bad)
+	}
+	if dst[0] != 1<<23 || dst[1] != -1<<22 || dst[2] != math.MaxInt32 || dst[3] != 0 {
+		t.Errorf("unexpected values %x", dst)
+	}
+
+	back := make([]goposit.Posit16, 3)
+	if rounded := goposit.Posit16SliceFromFixed32(back, dst[:3], 24); rounded != 1 {
+		t.Errorf("only the saturated value should round, got %v", rounded)
+	}
+	if back[0].Bits() != src[0].Bits() || back[1].Bits() != src[1].Bits() || f16(back[2]) != 128 {
+		t.Errorf("unexpected posits %v %v %v", f16(back[0]), f16(back[1]), f16(back[2]))
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("mismatched lengths should panic")
+		}
+	}()
+	goposit.Posit16SliceFromFixed32(back, dst, 24)
+}
@@ -0,0 +1,504 @@
+package goposit_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+// These are native fuzz targets, run one with for example
+//
+//	go test -run XXX -fuzz FuzzPosit32
+//
+// Without -fuzz only the seed values are checked. Results are compared against the big.Rat
+// reference in verify_test.go.
+
+var ref64 = ref{64, 3}
+
+// ops adapts the methods of one posit type to raw bits so they can all be checked alike
+type ops struct {
+	r          ref
+	add        func(a, b uint64) uint64
+	sub        func(a, b uint64) uint64
+	mul        func(a, b uint64) uint64
+	div        func(a, b uint64) uint64
+	sqrt       func(a uint64) uint64
+	addExact   func(a, b uint64) (uint64, uint64)
+	subExact   func(a, b uint64) (uint64, uint64)
+	mulPromote func(a, b uint64) *big.Rat // the exact value of the result, nil if there is no bigger size
+	divPromote func(a, b uint64) uint64   // nil if there is no bigger size to check
+	up         func(a uint64) uint64      // nil if there is no bigger size to check
+	promoted   ref
+	down       func(a uint64) uint64 // nil if there is no smaller size
+	demoted    ref
+
+	// the integer conversions use words of r.nbits bits, nil for SlowPosit
+	toInt    func(a uint64) int64
+	toUint   func(a uint64) uint64
+	fromInt  func(a uint64) uint64 // the low r.nbits bits of a are a signed word
+	fromUint func(a uint64) uint64
+}
+
+func slowOps(nbits, es uint) ops {
+	mk := func(bits uint64) *goposit.SlowPosit {
+		p := goposit.NewSlowPosit(nbits, es)
+		p.SetBits(new(big.Int).SetUint64(bits))
+		return p
+	}
+	o := ops{
+		r:    ref{nbits, es},
+		add:  func(a, b uint64) uint64 { return mk(a).Add(mk(b)).Uint64() },
+		sub:  func(a, b uint64) uint64 { return mk(a).Sub(mk(b)).Uint64() },
+		mul:  func(a, b uint64) uint64 { return mk(a).Mul(mk(b)).Uint64() },
+		div:  func(a, b uint64) uint64 { return mk(a).Div(mk(b)).Uint64() },
+		sqrt: func(a uint64) uint64 { return mk(a).Sqrt().Uint64() },
+		addExact: func(a, b uint64) (uint64, uint64) {
+			z, r := mk(a).AddExact(mk(b))
+			return z.Uint64(), r.Uint64()
+		},
+		subExact: func(a, b uint64) (uint64, uint64) {
+			z, r := mk(a).SubExact(mk(b))
+			return z.Uint64(), r.Uint64()
+		},
+	}
+	if 2*nbits <= 64 {
+		o.promoted = ref{2 * nbits, es + 1}
+		o.mulPromote = func(a, b uint64) *big.Rat { return o.promoted.value(mk(a).MulPromote(mk(b)).Uint64()) }
+		o.divPromote = func(a, b uint64) uint64 { return mk(a).DivPromote(mk(b)).Uint64() }
+	}
+	return o
+}
+
+// checkOps compares every arithmetic method against the reference for one pair of inputs
+func checkOps(t *testing.T, o ops, a, b uint64) {
+	r := o.r
+	a, b = a&r.mask(), b&r.mask()
+	xv, yv := r.value(a), r.value(b)
+	expect := func(f func(z, x, y *big.Rat) *big.Rat) uint64 {
+		if xv == nil || yv == nil {
+			return r.nar()
+		}
+		return r.round(f(new(big.Rat), xv, yv), false)
+	}
+	fail := func(op string, got, want uint64) {
+		t.Fatalf("%v %v %v: got %v expected %v", op, describe(r.nbits, r.es, a), describe(r.nbits, r.es, b),
+			describe(r.nbits, r.es, got), describe(r.nbits, r.es, want))
+	}
+	if got, want := o.add(a, b), expect((*big.Rat).Add); got != want {
+		fail("Add", got, want)
+	}
+	if got, want := o.sub(a, b), expect((*big.Rat).Sub); got != want {
+		fail("Sub", got, want)
+	}
+	if got, want := o.mul(a, b), expect((*big.Rat).Mul); got != want {
+		fail("Mul", got, want)
+	}
+	want := r.nar()
+	if xv != nil && yv != nil && yv.Sign() != 0 {
+		want = r.round(new(big.Rat).Quo(xv, yv), false)
+	}
+	if got := o.div(a, b); got != want {
+		fail("Div", got, want)
+	}
+	want = r.nar()
+	if xv != nil && xv.Sign() >= 0 {
+		want = r.sqrt(xv)
+	}
+	if got := o.sqrt(a); got != want {
+		fail("Sqrt", got, want)
+	}
+	checkConversions(t, o, a)
+	if o.divPromote != nil {
+		want := o.promoted.nar()
+		if xv != nil && yv != nil && yv.Sign() != 0 {
+			want = o.promoted.round(new(big.Rat).Quo(xv, yv), false)
+		}
+		if got := o.divPromote(a, b); got != want {
+			t.Fatalf("DivPromote %v %v: got %v expected %v", describe(r.nbits, r.es, a), describe(r.nbits, r.es, b),
+				describe(o.promoted.nbits, o.promoted.es, got), describe(o.promoted.nbits, o.promoted.es, want))
+		}
+	}
+	if xv == nil || yv == nil {
+		return
+	}
+
+	// the sum is the largest posit not above the true sum and the remainder is what is left,
+	// if the remainder fits in a posit then adding the two gives exactly the true sum
+	checkExact(t, r, "AddExact", a, b, new(big.Rat).Add(xv, yv), o.addExact)
+	checkExact(t, r, "SubExact", a, b, new(big.Rat).Sub(xv, yv), o.subExact)
+
+	if o.mulPromote != nil {
+		prod := new(big.Rat).Mul(xv, yv)
+		if v := o.mulPromote(a, b); v == nil || v.Cmp(prod) != 0 {
+			t.Fatalf("MulPromote %x %x: %v is not exactly %v", a, b, v, prod.FloatString(20))
+		}
+	}
+}
+
+// checkExact checks one result of AddExact or SubExact against the true result
+func checkExact(t *testing.T, r ref, op string, a, b uint64, exact *big.Rat, f func(a, b uint64) (uint64, uint64)) {
+	z, rem := f(a, b)
+	if zb := r.floor(exact); z != zb {
+		t.Fatalf("%v %v %v: got %v expected %v", op, describe(r.nbits, r.es, a), describe(r.nbits, r.es, b),
+			describe(r.nbits, r.es, z), describe(r.nbits, r.es, zb))
+	}
+	left := new(big.Rat).Sub(exact, r.value(z))
+	if want := r.round(left, false); rem != want {
+		t.Fatalf("%v %v %v: remainder %v expected %v", op, describe(r.nbits, r.es, a), describe(r.nbits, r.es, b),
+			describe(r.nbits, r.es, rem), describe(r.nbits, r.es, want))
+	}
+	if r.value(rem).Cmp(left) == 0 && new(big.Rat).Add(r.value(z), r.value(rem)).Cmp(exact) != 0 {
+		t.Fatalf("%v %x %x: %x + %x is not the exact result", op, a, b, z, rem)
+	}
+}
+
+// checkConversions checks the conversions between sizes and to and from integers of one posit
+func checkConversions(t *testing.T, o ops, a uint64) {
+	r := o.r
+	xv := r.value(a)
+	if o.up != nil {
+		got := o.up(a)
+		if v := o.promoted.value(got); (v == nil) != (xv == nil) || (v != nil && v.Cmp(xv) != 0) {
+			t.Fatalf("Up %v: got %v", describe(r.nbits, r.es, a), describe(o.promoted.nbits, o.promoted.es, got))
+		}
+	}
+	if o.down != nil {
+		want := o.demoted.nar()
+		if xv != nil {
+			want = o.demoted.round(xv, false)
+		}
+		if got := o.down(a); got != want {
+			t.Fatalf("Down %v: got %v expected %v", describe(r.nbits, r.es, a),
+				describe(o.demoted.nbits, o.demoted.es, got), describe(o.demoted.nbits, o.demoted.es, want))
+		}
+	}
+	if o.toInt == nil {
+		return
+	}
+
+	// Int rounds to nearest even and saturates at +/-max with NaR giving max, Uint gives
+	// 0 for negatives and saturates at max which NaR also gives
+	smax := new(big.Int).Lsh(big.NewInt(1), r.nbits-1)
+	smax.Sub(smax, big.NewInt(1))
+	umax := new(big.Int).Lsh(big.NewInt(1), r.nbits)
+	umax.Sub(umax, big.NewInt(1))
+	ival, uval := new(big.Int).Set(smax), new(big.Int).Set(umax)
+	if xv != nil {
+		i := roundInt(xv)
+		ival.Set(i)
+		if i.CmpAbs(smax) > 0 {
+			ival.Mul(smax, big.NewInt(int64(i.Sign())))
+		}
+		uval.Set(i)
+		if i.Sign() < 0 {
+			uval.SetInt64(0)
+		} else if i.Cmp(umax) > 0 {
+			uval.Set(umax)
+		}
+	}
+	if got := o.toInt(a); got != ival.Int64() {
+		t.Fatalf("Int %v: got %d expected %v", describe(r.nbits, r.es, a), got, ival)
+	}
+	if got := o.toUint(a); got != uval.Uint64() {
+		t.Fatalf("Uint %v: got %d expected %v", describe(r.nbits, r.es, a), got, uval)
+	}
+
+	// a as a signed word is the low bits sign extended
+	word := new(big.Int).SetUint64(a)
+	if a&r.nar() != 0 {
+		word.Sub(word, new(big.Int).Lsh(big.NewInt(1), r.nbits))
+	}
+	if got, want := o.fromInt(a), r.round(new(big.Rat).SetInt(word), false); got != want {
+		t.Fatalf("FromInt(%v): got %v expected %v", word, describe(r.nbits, r.es, got), describe(r.nbits, r.es, want))
+	}
+	u := new(big.Rat).SetInt(new(big.Int).SetUint64(a))
+	if got, want := o.fromUint(a), r.round(u, false); got != want {
+		t.Fatalf("FromUint(%d): got %v expected %v", a, describe(r.nbits, r.es, got), describe(r.nbits, r.es, want))
+	}
+}
+
+func addSeeds(f *testing.F, nbits uint) {
+	nar := uint64(1) << (nbits - 1)
+	one := nar >> 1
+	for _, s := range [][2]uint64{{0, 0}, {one, one}, {nar, one}, {one, 0}, {nar - 1, nar - 1},
+		{1, nar - 1}, {nar + 1, 1}, {one + 1, ^one}, {3, 5}} {
+		f.Add(s[0], s[1])
+	}
+}
+
+func FuzzPosit8(f *testing.F) {
+	addSeeds(f, 8)
+	p := goposit.NewPosit8()
+	o := ops{
+		r:    ref8,
+		add:  func(a, b uint64) uint64 { return uint64(p.SetBits(uint8(a)).Add(p.SetBits(uint8(b))).Bits()) },
+		sub:  func(a, b uint64) uint64 { return uint64(p.SetBits(uint8(a)).Sub(p.SetBits(uint8(b))).Bits()) },
+		mul:  func(a, b uint64) uint64 { return uint64(p.SetBits(uint8(a)).Mul(p.SetBits(uint8(b))).Bits()) },
+		div:  func(a, b uint64) uint64 { return uint64(p.SetBits(uint8(a)).Div(p.SetBits(uint8(b))).Bits()) },
+		sqrt: func(a uint64) uint64 { return uint64(p.SetBits(uint8(a)).Sqrt().Bits()) },
+		addExact: func(a, b uint64) (uint64, uint64) {
+			z, r := p.SetBits(uint8(a)).AddExact(p.SetBits(uint8(b)))
+			return uint64(z.Bits()), uint64(r.Bits())
+		},
+		subExact: func(a, b uint64) (uint64, uint64) {
+			z, r := p.SetBits(uint8(a)).SubExact(p.SetBits(uint8(b)))
+			return uint64(z.Bits()), uint64(r.Bits())
+		},
+		mulPromote: func(a, b uint64) *big.Rat {
+			return ref16.value(uint64(p.SetBits(uint8(a)).MulPromote(p.SetBits(uint8(b))).Bits()))
+		},
+		divPromote: func(a, b uint64) uint64 {
+			return uint64(p.SetBits(uint8(a)).DivPromote(p.SetBits(uint8(b))).Bits())
+		},
+		up:       func(a uint64) uint64 { return uint64(p.SetBits(uint8(a)).Up().Bits()) },
+		promoted: ref16,
+		toInt:    func(a uint64) int64 { return int64(p.SetBits(uint8(a)).Int()) },
+		toUint:   func(a uint64) uint64 { return uint64(p.SetBits(uint8(a)).Uint()) },
+		fromInt:  func(a uint64) uint64 { return uint64(p.FromInt(int8(a)).Bits()) },
+		fromUint: func(a uint64) uint64 { return uint64(p.FromUint(uint8(a)).Bits()) },
+	}
+	f.Fuzz(func(t *testing.T, a, b uint64) { checkOps(t, o, a, b) })
+}
+
+func FuzzPosit16(f *testing.F) {
+	addSeeds(f, 16)
+	p := goposit.NewPosit16()
+	o := ops{
+		r:    ref16,
+		add:  func(a, b uint64) uint64 { return uint64(p.SetBits(uint16(a)).Add(p.SetBits(uint16(b))).Bits()) },
+		sub:  func(a, b uint64) uint64 { return uint64(p.SetBits(uint16(a)).Sub(p.SetBits(uint16(b))).Bits()) },
+		mul:  func(a, b uint64) uint64 { return uint64(p.SetBits(uint16(a)).Mul(p.SetBits(uint16(b))).Bits()) },
+		div:  func(a, b uint64) uint64 { return uint64(p.SetBits(uint16(a)).Div(p.SetBits(uint16(b))).Bits()) },
+		sqrt: func(a uint64) uint64 { return uint64(p.SetBits(uint16(a)).Sqrt().Bits()) },
+		addExact: func(a, b uint64) (uint64, uint64) {
+			z, r := p.SetBits(uint16(a)).AddExact(p.SetBits(uint16(b)))
+			return uint64(z.Bits()), uint64(r.Bits())
+		},
+		subExact: func(a, b uint64) (uint64, uint64) {
+			z, r := p.SetBits(uint16(a)).SubExact(p.SetBits(uint16(b)))
+			return uint64(z.Bits()), uint64(r.Bits())
+		},
+		mulPromote: func(a, b uint64) *big.Rat {
+			return ref32.value(uint64(p.SetBits(uint16(a)).MulPromote(p.SetBits(uint16(b))).Bits()))
+		},
+		divPromote: func(a, b uint64) uint64 {
+			return uint64(p.SetBits(uint16(a)).DivPromote(p.SetBits(uint16(b))).Bits())
+		},
+		up:       func(a uint64) uint64 { return uint64(p.SetBits(uint16(a)).Up().Bits()) },
+		promoted: ref32,
+		down:     func(a uint64) uint64 { return uint64(p.SetBits(uint16(a)).Down().Bits()) },
+		demoted:  ref8,
+		toInt:    func(a uint64) int64 { return int64(p.SetBits(uint16(a)).Int()) },
+		toUint:   func(a uint64) uint64 { return uint64(p.SetBits(uint16(a)).Uint()) },
+		fromInt:  func(a uint64) uint64 { return uint64(p.FromInt(int16(a)).Bits()) },
+		fromUint: func(a uint64) uint64 { return uint64(p.FromUint(uint16(a)).Bits()) },
+	}
+	f.Fuzz(func(t *testing.T, a, b uint64) { checkOps(t, o, a, b) })
+}
+
+func FuzzPosit32(f *testing.F) {
+	addSeeds(f, 32)
+	p := goposit.NewPosit32()
+	o := ops{
+		r:    ref32,
+		add:  func(a, b uint64) uint64 { return uint64(p.SetBits(uint32(a)).Add(p.SetBits(uint32(b))).Bits()) },
+		sub:  func(a, b uint64) uint64 { return uint64(p.SetBits(uint32(a)).Sub(p.SetBits(uint32(b))).Bits()) },
+		mul:  func(a, b uint64) uint64 { return uint64(p.SetBits(uint32(a)).Mul(p.SetBits(uint32(b))).Bits()) },
+		div:  func(a, b uint64) uint64 { return uint64(p.SetBits(uint32(a)).Div(p.SetBits(uint32(b))).Bits()) },
+		sqrt: func(a uint64) uint64 { return uint64(p.SetBits(uint32(a)).Sqrt().Bits()) },
+		addExact: func(a, b uint64) (uint64, uint64) {
+			z, r := p.SetBits(uint32(a)).AddExact(p.SetBits(uint32(b)))
+			return uint64(z.Bits()), uint64(r.Bits())
+		},
+		subExact: func(a, b uint64) (uint64, uint64) {
+			z, r := p.SetBits(uint32(a)).SubExact(p.SetBits(uint32(b)))
+			return uint64(z.Bits()), uint64(r.Bits())
+		},
+		mulPromote: func(a, b uint64) *big.Rat {
+			return ref64.value(p.SetBits(uint32(a)).MulPromote(p.SetBits(uint32(b))).Bits())
+		},
+		divPromote: func(a, b uint64) uint64 {
+			return p.SetBits(uint32(a)).DivPromote(p.SetBits(uint32(b))).Bits()
+		},
+		up:       func(a uint64) uint64 { return p.SetBits(uint32(a)).Up().Bits() },
+		promoted: ref64,
+		down:     func(a uint64) uint64 { return uint64(p.SetBits(uint32(a)).Down().Bits()) },
+		demoted:  ref16,
+		toInt:    func(a uint64) int64 { return int64(p.SetBits(uint32(a)).Int()) },
+		toUint:   func(a uint64) uint64 { return uint64(p.SetBits(uint32(a)).Uint()) },
+		fromInt:  func(a uint64) uint64 { return uint64(p.FromInt(int32(a)).Bits()) },
+		fromUint: func(a uint64) uint64 { return uint64(p.FromUint(uint32(a)).Bits()) },
+	}
+	f.Fuzz(func(t *testing.T, a, b uint64) { checkOps(t, o, a, b) })
+}
+
+func FuzzPosit64(f *testing.F) {
+	addSeeds(f, 64)
+	p := goposit.NewPosit64()
+	o := ops{
+		r:    ref64,
+		add:  func(a, b uint64) uint64 { return p.SetBits(a).Add(p.SetBits(b)).Bits() },
+		sub:  func(a, b uint64) uint64 { return p.SetBits(a).Sub(p.SetBits(b)).Bits() },
+		mul:  func(a, b uint64) uint64 { return p.SetBits(a).Mul(p.SetBits(b)).Bits() },
+		div:  func(a, b uint64) uint64 { return p.SetBits(a).Div(p.SetBits(b)).Bits() },
+		sqrt: func(a uint64) uint64 { return p.SetBits(a).Sqrt().Bits() },
+		addExact: func(a, b uint64) (uint64, uint64) {
+			z, r := p.SetBits(a).AddExact(p.SetBits(b))
+			return z.Bits(), r.Bits()
+		},
+		subExact: func(a, b uint64) (uint64, uint64) {
+			z, r := p.SetBits(a).SubExact(p.SetBits(b))
+			return z.Bits(), r.Bits()
+		},
+		// a Posit128 is too big for the reference so its exact value is read with Rat
+		mulPromote: func(a, b uint64) *big.Rat { return p.SetBits(a).MulPromote(p.SetBits(b)).Rat() },
+		down:       func(a uint64) uint64 { return uint64(p.SetBits(a).Down().Bits()) },
+		demoted:    ref32,
+		toInt:      func(a uint64) int64 { return p.SetBits(a).Int() },
+		toUint:     func(a uint64) uint64 { return p.SetBits(a).Uint() },
+		fromInt:    func(a uint64) uint64 { return p.FromInt(int64(a)).Bits() },
+		fromUint:   func(a uint64) uint64 { return p.FromUint(a).Bits() },
+	}
+	f.Fuzz(func(t *testing.T, a, b uint64) { checkOps(t, o, a, b) })
+}
+
+// FuzzSlowPosit checks every size from 4 to 64 bits with any es which fits
+func FuzzSlowPosit(f *testing.F) {
+	for _, s := range []struct {
+		nbits, es uint8
+		a, b      uint64
+	}{{6, 1, 0x0b, 0x31}, {12, 2, 0x7ff, 0x001}, {24, 0, 0x800000, 0x400000}, {5, 1, 0x0f, 0x10}, {40, 4, 1, 3}} {
+		f.Add(s.nbits, s.es, s.a, s.b)
+	}
+	f.Fuzz(func(t *testing.T, nbits, es uint8, a, b uint64) {
+		n := 4 + uint(nbits)%61
+		e := uint(es) % 6
+		if e+3 >= n {
+			e = n - 4
+		}
+		checkOps(t, slowOps(n, e), a, b)
+	})
+}
+
+// FuzzFloat checks FromFloat rounding to nearest and truncating, and that ToFloat is exact
+func FuzzFloat(f *testing.F) {
+	for _, s := range []float64{0, 1, -1, 1.5, math.Pi, 1e-300, -1e300, 3.0517578125e-05, math.NaN()} {
+		f.Add(s, uint8(16), uint8(1))
+	}
+	f.Fuzz(func(t *testing.T, x float64, nbits, es uint8) {
+		n := 4 + uint(nbits)%61
+		e := uint(es) % 6
+		if e+3 >= n {
+			e = n - 4
+		}
+		r := ref{n, e}
+		p := goposit.NewSlowPosit(n, e)
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			if !math.IsNaN(x) {
+				p.FromFloat(big.NewFloat(x), false)
+				if !p.IsNaR() {
+					t.Fatalf("%v should convert to NaR", x)
+				}
+			}
+			return
+		}
+		v := new(big.Rat).SetFloat64(x)
+		exact := p.FromFloat(big.NewFloat(x), false)
+		if want := r.round(v, false); p.Uint64() != want {
+			t.Fatalf("FromFloat(%v) %d bits es %d: got %v expected %v", x, n, e,
+				describe(n, e, p.Uint64()), describe(n, e, want))
+		}
+		back, _ := p.ToFloat().Rat(nil)
+		if back.Cmp(r.value(p.Uint64())) != 0 {
+			t.Fatalf("ToFloat of %v is %v", describe(n, e, p.Uint64()), back.FloatString(30))
+		}
+		if exact != (back.Cmp(v) == 0) {
+			t.Fatalf("FromFloat(%v) reported exact=%v for %v", x, exact, describe(n, e, p.Uint64()))
+		}
+
+		// truncation rounds the magnitude down, tiny numbers may become zero
+		p.FromFloat(big.NewFloat(x), true)
+		want := uint64(0)
+		if v.Sign() != 0 {
+			want = r.floor(new(big.Rat).Abs(v))
+			if r.value(want).Sign() < 0 {
+				// below zero means the magnitude is less than minpos
+				want = 0
+			}
+			if v.Sign() < 0 {
+				want = -want & r.mask()
+			}
+		}
+		if p.Uint64() != want {
+			t.Fatalf("FromFloat(%v, truncate) %d bits es %d: got %v expected %v", x, n, e,
+				describe(n, e, p.Uint64()), describe(n, e, want))
+		}
+	})
+}
+
+// FuzzVector checks that every lane of a vector operation is the same as the scalar one
+func FuzzVector(f *testing.F) {
+	f.Add(uint32(0x40c08001), uint32(0x7f014040))
+	f.Fuzz(func(t *testing.T, a, b uint32) {
+		p := goposit.NewPosit8()
+		x := goposit.NewPosit8x4(p)
+		y := goposit.NewPosit8x4(p)
+		for i := 0; i < 4; i++ {
+			x.Put(i, p.SetBits(uint8(a>>(8*i))))
+			y.Put(i, p.SetBits(uint8(b>>(8*i))))
+		}
+		z, rem := x.AddExact(y)
+		for _, c := range []struct {
+			name   string
+			vec    goposit.Posit8x4
+			scalar func(x, y goposit.Posit8) goposit.Posit8
+		}{
+			{"Add", x.Add(y), goposit.Posit8.Add},
+			{"Sub", x.Sub(y), goposit.Posit8.Sub},
+			{"Mul", x.Mul(y), goposit.Posit8.Mul},
+			{"Div", x.Div(y), goposit.Posit8.Div},
+			{"Sqrt", x.Sqrt(), func(x, _ goposit.Posit8) goposit.Posit8 { return x.Sqrt() }},
+			{"AddExact", z, func(x, y goposit.Posit8) goposit.Posit8 { z, _ := x.AddExact(y); return z }},
+			{"AddExact remainder", rem, func(x, y goposit.Posit8) goposit.Posit8 { _, r := x.AddExact(y); return r }},
+		} {
+			for i := 0; i < 4; i++ {
+				want := c.scalar(x.Get(i), y.Get(i))
+				if got := c.vec.Get(i); got.Bits() != want.Bits() {
+					t.Fatalf("Posit8x4 %v lane %d of %x %x: vector %x scalar %x", c.name, i, a, b, got.Bits(), want.Bits())
+				}
+			}
+		}
+
+		// the same bits again as two Posit16 lanes
+		q := goposit.NewPosit16()
+		x16 := goposit.NewPosit16x2(q)
+		y16 := goposit.NewPosit16x2(q)
+		for i := 0; i < 2; i++ {
+			x16.Put(i, q.SetBits(uint16(a>>(16*i))))
+			y16.Put(i, q.SetBits(uint16(b>>(16*i))))
+		}
+		z16, rem16 := x16.AddExact(y16)
+		for _, c := range []struct {
+			name   string
+			vec    goposit.Posit16x2
+			scalar func(x, y goposit.Posit16) goposit.Posit16
+		}{
+			{"Add", x16.Add(y16), goposit.Posit16.Add},
+			{"Sub", x16.Sub(y16), goposit.Posit16.Sub},
+			{"Mul", x16.Mul(y16), goposit.Posit16.Mul},
+			{"Div", x16.Div(y16), goposit.Posit16.Div},
+			{"Sqrt", x16.Sqrt(), func(x, _ goposit.Posit16) goposit.Posit16 { return x.Sqrt() }},
+			{"AddExact", z16, func(x, y goposit.Posit16) goposit.Posit16 { z, _ := x.AddExact(y); return z }},
+			{"AddExact remainder", rem16, func(x, y goposit.Posit16) goposit.Posit16 { _, r := x.AddExact(y); return r }},
+		} {
+			for i := 0; i < 2; i++ {
+				want := c.scalar(x16.Get(i), y16.Get(i))
+				if got := c.vec.Get(i); got.Bits() != want.Bits() {
+					t.Fatalf("Posit16x2 %v lane %d of %x %x: vector %x scalar %x", c.name, i, a, b, got.Bits(), want.Bits())
+				}
+			}
+		}
+	})
+}
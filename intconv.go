@@ -0,0 +1,99 @@
+package goposit
+
+import (
+	"math/big"
+)
+
+// RoundingMode selects how a posit is rounded when it is converted to an integer
+type RoundingMode int
+
+const (
+	// RoundNearestEven rounds to the nearest integer, ties to even, like Int
+	RoundNearestEven RoundingMode = iota
+
+	// RoundTruncate rounds toward zero
+	RoundTruncate
+
+	// RoundFloor rounds toward negative infinity
+	RoundFloor
+
+	// RoundCeil rounds toward positive infinity
+	RoundCeil
+)
+
+// roundInt rounds f to an integer in the given mode
+func roundInt(f *big.Float, mode RoundingMode) *big.Int {
+	if mode == RoundNearestEven {
+		return roundEven(f)
+	}
+	out, acc := f.Int(nil)
+	switch {
+	case mode == RoundFloor && acc == big.Above:
+		out.Sub(out, bigi1)
+	case mode == RoundCeil && acc == big.Below:
+		out.Add(out, bigi1)
+	}
+	return out
+}
+
+// ToBigInt returns the value of the posit rounded to an integer in the given mode, or nil
+// if the posit is NaR.
+func (p *SlowPosit) ToBigInt(mode RoundingMode) *big.Int {
+	if p.IsNaR() {
+		return nil
+	}
+	return roundInt(p.ToFloat(), mode)
+}
+
+// saturateInt returns x as a signed integer of the given number of bits, if it is out of
+// range then the result saturates and ok is false.
+func saturateInt(x *big.Int, bits uint) (i int64, ok bool) {
+	max := new(big.Int).Lsh(bigi1, bits-1)
+	min := new(big.Int).Neg(max)
+	max.Sub(max, bigi1)
+	switch {
+	case x.Cmp(max) > 0:
+		return max.Int64(), false
+	case x.Cmp(min) < 0:
+		return min.Int64(), false
+	}
+	return x.Int64(), true
+}
+
+// toInt converts the posit to a signed integer of the given number of bits, if it is out
+// of range then the result saturates and ok is false, NaR gives zero and false.
+func (p *SlowPosit) toInt(mode RoundingMode, bits uint) (i int64, ok bool) {
+	x := p.ToBigInt(mode)
+	if x == nil {
+		return 0, false
+	}
+	return saturateInt(x, bits)
+}
+
+// toUint converts the posit to an unsigned integer of the given number of bits, if it is
+// negative or too large then the result saturates to zero or the maximum and ok is false,
+// NaR gives zero and false.
+func (p *SlowPosit) toUint(mode RoundingMode, bits uint) (i uint64, ok bool) {
+	x := p.ToBigInt(mode)
+	if x == nil {
+		return 0, false
+	}
+	max := new(big.Int).Lsh(bigi1, bits)
+	max.Sub(max, bigi1)
+	switch {
+	case x.Sign() < 0:
+		return 0, false
+	case x.Cmp(max) > 0:
+		return max.Uint64(), false
+	}
+	return x.Uint64(), true
+}
+
+// ToInt64 converts the posit to an int64 rounding in the given mode, if the result is out
+// of range it saturates to the minimum or maximum and ok is false. NaR gives zero and false.
+func (p *SlowPosit) ToInt64(mode RoundingMode) (i int64, ok bool) { return p.toInt(mode, 64) }
+
+// ToUint64 converts the posit to a uint64 rounding in the given mode, if the result is
+// negative or too large it saturates to zero or the maximum and ok is false. NaR gives zero
+// and false.
+func (p *SlowPosit) ToUint64(mode RoundingMode) (i uint64, ok bool) { return p.toUint(mode, 64) }
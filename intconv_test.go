@@ -0,0 +1,81 @@
+package goposit_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+func TestToInt(t *testing.T) {
+	modes := []goposit.RoundingMode{
+		goposit.RoundNearestEven, goposit.RoundTruncate, goposit.RoundFloor, goposit.RoundCeil,
+	}
+	for _, c := range []struct {
+		f    float64
+		want [4]int16
+	}{
+		{2.5, [4]int16{2, 2, 2, 3}},
+		{3.5, [4]int16{4, 3, 3, 4}},
+		{-2.5, [4]int16{-2, -2, -3, -2}},
+		{-0.25, [4]int16{0, 0, -1, 0}},
+		{0.75, [4]int16{1, 0, 0, 1}},
+		{7, [4]int16{7, 7, 7, 7}},
+		{-7, [4]int16{-7, -7, -7, -7}},
+	} {
+		for i, mode := range modes {
+			if got, ok := p16(c.f).ToInt(mode); got != c.want[i] || !ok {
+				t.Errorf("%v in mode %v: got %v %v want %v", c.f, mode, got, ok, c.want[i])
+			}
+		}
+	}
+
+	// Posit16 reaches 2**28 which does not fit in an int16
+	maxpos := goposit.Posit16MaxPos()
+	if got, ok := maxpos.ToInt(goposit.RoundTruncate); got != math.MaxInt16 || ok {
+		t.Errorf("maxpos should saturate, got %v %v", got, ok)
+	}
+	if got, ok := maxpos.ToInt64(goposit.RoundTruncate); got != 1<<28 || !ok {
+		t.Errorf("maxpos should fit in an int64, got %v %v", got, ok)
+	}
+	if got, ok := p16(-1e6).ToInt16(goposit.RoundCeil); got != math.MinInt16 || ok {
+		t.Errorf("-1e6 should saturate to the minimum, got %v %v", got, ok)
+	}
+	if got, ok := goposit.Posit16NaR().ToInt32(goposit.RoundNearestEven); got != 0 || ok {
+		t.Errorf("NaR should not convert, got %v %v", got, ok)
+	}
+}
+
+func TestToUint(t *testing.T) {
+	if got := p16(-5).Uint(); got != 0 {
+		t.Errorf("a negative number should give 0, got %v", got)
+	}
+	if got := p16(1e6).Uint(); got != math.MaxUint16 {
+		t.Errorf("a large number should saturate, got %v", got)
+	}
+
+	// 1.5 * 2**63 does not fit in an int64 but does fit in a uint64
+	sp := goposit.NewSlowPosit(64, 3)
+	sp.FromFloat(new(big.Float).SetMantExp(big.NewFloat(1.5), 63), false)
+	x := goposit.NewPosit64().SetBits(sp.Uint64())
+	if got := x.Uint(); got != 3<<62 {
+		t.Errorf("Uint should not saturate at the signed maximum, got %x", got)
+	}
+	if got, ok := x.ToUint(goposit.RoundTruncate); got != 3<<62 || !ok {
+		t.Errorf("ToUint should be exact, got %x %v", got, ok)
+	}
+	if got, ok := x.ToUint8(goposit.RoundTruncate); got != math.MaxUint8 || ok {
+		t.Errorf("Posit64 to uint8 should saturate, got %v %v", got, ok)
+	}
+
+	if got, ok := p16(-0.25).ToUint(goposit.RoundCeil); got != 0 || !ok {
+		t.Errorf("-0.25 rounded up is zero, got %v %v", got, ok)
+	}
+	if got, ok := p16(-0.25).ToUint(goposit.RoundFloor); got != 0 || ok {
+		t.Errorf("-0.25 rounded down is negative, got %v %v", got, ok)
+	}
+	if got, ok := goposit.Posit8NaR().ToUint64(goposit.RoundNearestEven); got != 0 || ok {
+		t.Errorf("NaR should not convert, got %v %v", got, ok)
+	}
+}
@@ -0,0 +1,27 @@
+// Package positfmt formats posits for the command line tools.
+package positfmt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cjdelisle/goposit"
+)
+
+// HexBits is the bits of the posit in hex with 0x and a digit for every 4 bits
+func HexBits(p *goposit.SlowPosit) string {
+	return fmt.Sprintf("0x%0*x", (p.Nbits()+3)/4, p.Bits)
+}
+
+// Exact is the exact decimal value of the posit, which always has a finite expansion
+func Exact(p *goposit.SlowPosit) string {
+	if p.IsNaR() {
+		return "NaR"
+	}
+	r, _ := p.ToFloat().Rat(nil)
+	s := r.FloatString(r.Denom().BitLen() - 1)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
@@ -0,0 +1,33 @@
+package positfmt_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+	"github.com/cjdelisle/goposit/internal/positfmt"
+)
+
+func TestFormat(t *testing.T) {
+	p := goposit.NewSlowPosit(12, 1)
+	for _, c := range []struct {
+		bits       int64
+		hex, exact string
+	}{
+		{0x000, "0x000", "0"},
+		{0x400, "0x400", "1"},
+		{0x800, "0x800", "NaR"},
+		{0x001, "0x001", "0.00000095367431640625"},
+		{0xc00, "0xc00", "-1"},
+		{0x500, "0x500", "2"},
+		{0x480, "0x480", "1.5"},
+	} {
+		p.SetBits(big.NewInt(c.bits))
+		if got := positfmt.HexBits(p); got != c.hex {
+			t.Errorf("HexBits(%x) = %v", c.bits, got)
+		}
+		if got := positfmt.Exact(p); got != c.exact {
+			t.Errorf("Exact(%x) = %v expected %v", c.bits, got, c.exact)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package goposit
+
+import (
+	"math/big"
+)
+
+// SlowInterval is a closed interval [Lo, Hi] of SlowPosits, every operation rounds the
+// lower bound toward negative infinity and the upper bound toward positive infinity so
+// the true result is always enclosed. Because posits have no infinity, a bound which is
+// NaR means the interval is unbounded on that side.
+type SlowInterval struct {
+	Lo *SlowPosit
+	Hi *SlowPosit
+}
+
+// NewSlowInterval creates the interval [lo, hi], if lo is greater than hi it will panic
+func NewSlowInterval(lo, hi *SlowPosit) *SlowInterval {
+	assertCompat(lo, hi)
+	if !lo.IsNaR() && !hi.IsNaR() && lo.ToFloat().Cmp(hi.ToFloat()) > 0 {
+		panic("interval lower bound is greater than upper bound")
+	}
+	return &SlowInterval{Lo: lo, Hi: hi}
+}
+
+// roundDown converts f to the largest posit which is less than or equal to it, if there
+// is none then NaR (unbounded) is returned.
+func roundDown(template *SlowPosit, f *big.Float) *SlowPosit {
+	if f.IsInf() {
+		return template.Clone().NaR()
+	}
+	out := outPosit(template, f)
+	if out.ToFloat().Cmp(f) > 0 {
+		out = out.NextDown()
+	}
+	return out
+}
+
+// roundUp converts f to the smallest posit which is greater than or equal to it, if
+// there is none then NaR (unbounded) is returned.
+func roundUp(template *SlowPosit, f *big.Float) *SlowPosit {
+	if f.IsInf() {
+		return template.Clone().NaR()
+	}
+	out := outPosit(template, f)
+	if out.ToFloat().Cmp(f) < 0 {
+		out = out.NextUp()
+	}
+	return out
+}
+
+// bounds returns the interval as floats with NaR as -Inf and +Inf
+func (v *SlowInterval) bounds() (lo, hi *big.Float) {
+	lo = v.Lo.ToFloat()
+	if v.Lo.IsNaR() {
+		lo.SetInf(true)
+	}
+	hi = v.Hi.ToFloat()
+	if v.Hi.IsNaR() {
+		hi.SetInf(false)
+	}
+	return
+}
+
+func (v *SlowInterval) fromBounds(lo, hi *big.Float) *SlowInterval {
+	return &SlowInterval{Lo: roundDown(v.Lo, lo), Hi: roundUp(v.Lo, hi)}
+}
+
+func (v *SlowInterval) entire() *SlowInterval {
+	return &SlowInterval{Lo: v.Lo.Clone().NaR(), Hi: v.Lo.Clone().NaR()}
+}
+
+// addBounds adds two bounds exactly, infinities are never of opposite sign here
+func addBounds(x, y *big.Float) *big.Float {
+	if x.IsInf() {
+		return x
+	}
+	if y.IsInf() {
+		return y
+	}
+	return exactAdd(x, y)
+}
+
+// mulBounds multiplies two bounds exactly, in interval arithmetic 0 * Inf is 0
+func mulBounds(x, y *big.Float) *big.Float {
+	if x.Sign() == 0 || y.Sign() == 0 {
+		return new(big.Float)
+	}
+	out := new(big.Float).SetPrec(x.Prec() + y.Prec())
+	return out.Mul(x, y)
+}
+
+func minMax(fs ...*big.Float) (min, max *big.Float) {
+	min, max = fs[0], fs[0]
+	for _, f := range fs[1:] {
+		if f.Cmp(min) < 0 {
+			min = f
+		}
+		if f.Cmp(max) > 0 {
+			max = f
+		}
+	}
+	return
+}
+
+// Add returns an interval enclosing every sum of a number in v and a number in x
+func (v *SlowInterval) Add(x *SlowInterval) *SlowInterval {
+	assertCompat(v.Lo, x.Lo)
+	alo, ahi := v.bounds()
+	blo, bhi := x.bounds()
+	return v.fromBounds(addBounds(alo, blo), addBounds(ahi, bhi))
+}
+
+// Sub returns an interval enclosing every difference of a number in v and a number in x
+func (v *SlowInterval) Sub(x *SlowInterval) *SlowInterval {
+	assertCompat(v.Lo, x.Lo)
+	alo, ahi := v.bounds()
+	blo, bhi := x.bounds()
+	return v.fromBounds(addBounds(alo, bhi.Neg(bhi)), addBounds(ahi, blo.Neg(blo)))
+}
+
+// Mul returns an interval enclosing every product of a number in v and a number in x
+func (v *SlowInterval) Mul(x *SlowInterval) *SlowInterval {
+	assertCompat(v.Lo, x.Lo)
+	alo, ahi := v.bounds()
+	blo, bhi := x.bounds()
+	lo, hi := minMax(mulBounds(alo, blo), mulBounds(alo, bhi), mulBounds(ahi, blo), mulBounds(ahi, bhi))
+	return v.fromBounds(lo, hi)
+}
+
+// Div returns an interval enclosing every quotent of a number in v and a number in x,
+// if x contains zero or is itself unbounded then the result is unbounded.
+func (v *SlowInterval) Div(x *SlowInterval) *SlowInterval {
+	assertCompat(v.Lo, x.Lo)
+	if x.Contains(x.Lo.Clone().Zero()) || x.Lo.IsNaR() || x.Hi.IsNaR() {
+		return v.entire()
+	}
+	alo, ahi := v.bounds()
+	blo, bhi := x.bounds()
+	prec := v.Lo.hiPrec()
+	quo := func(mode big.RoundingMode, a, b *big.Float) *big.Float {
+		return new(big.Float).SetPrec(prec).SetMode(mode).Quo(a, b)
+	}
+	lo, _ := minMax(quo(big.ToNegativeInf, alo, blo), quo(big.ToNegativeInf, alo, bhi),
+		quo(big.ToNegativeInf, ahi, blo), quo(big.ToNegativeInf, ahi, bhi))
+	_, hi := minMax(quo(big.ToPositiveInf, alo, blo), quo(big.ToPositiveInf, alo, bhi),
+		quo(big.ToPositiveInf, ahi, blo), quo(big.ToPositiveInf, ahi, bhi))
+	return v.fromBounds(lo, hi)
+}
+
+// sqrtBound returns the square root of f rounded in the direction dir
+func sqrtBound(template *SlowPosit, f *big.Float, dir int) *SlowPosit {
+	if f.IsInf() {
+		return template.Clone().NaR()
+	}
+	if f.Sign() == 0 {
+		return template.Clone().Zero()
+	}
+	s := newBig(template.hiPrec()).Sqrt(f)
+	out := outPosit(template, s)
+	// check the result by squaring it, which is exact
+	of := out.ToFloat()
+	sq := new(big.Float).SetPrec(2*of.Prec()).Mul(of, of)
+	if dir < 0 && sq.Cmp(f) > 0 {
+		out = out.NextDown()
+	} else if dir > 0 && sq.Cmp(f) < 0 {
+		out = out.NextUp()
+	}
+	return out
+}
+
+// Sqrt returns an interval enclosing the square roots of every non-negative number in v,
+// if v is entirely negative then the result is NaR on both sides.
+func (v *SlowInterval) Sqrt() *SlowInterval {
+	lo, hi := v.bounds()
+	if hi.Sign() < 0 {
+		return v.entire()
+	}
+	if lo.Sign() < 0 {
+		lo.SetInt64(0)
+	}
+	return &SlowInterval{Lo: sqrtBound(v.Lo, lo, -1), Hi: sqrtBound(v.Lo, hi, 1)}
+}
+
+// Contains returns true if x is within the interval, NaR is never contained
+func (v *SlowInterval) Contains(x *SlowPosit) bool {
+	assertCompat(v.Lo, x)
+	if x.IsNaR() {
+		return false
+	}
+	lo, hi := v.bounds()
+	xf := x.ToFloat()
+	return lo.Cmp(xf) <= 0 && xf.Cmp(hi) <= 0
+}
+
+// Width returns hi - lo rounded toward positive infinity, if the interval is unbounded
+// the width is NaR.
+func (v *SlowInterval) Width() *SlowPosit {
+	lo, hi := v.bounds()
+	if lo.IsInf() || hi.IsInf() {
+		return v.Lo.Clone().NaR()
+	}
+	return roundUp(v.Lo, exactAdd(hi, lo.Neg(lo)))
+}
+
+// Intersect returns the intersection of two intervals, if they do not overlap then the
+// second result is false.
+func (v *SlowInterval) Intersect(x *SlowInterval) (*SlowInterval, bool) {
+	assertCompat(v.Lo, x.Lo)
+	alo, ahi := v.bounds()
+	blo, bhi := x.bounds()
+	out := &SlowInterval{Lo: v.Lo, Hi: v.Hi}
+	if blo.Cmp(alo) > 0 {
+		alo = blo
+		out.Lo = x.Lo
+	}
+	if bhi.Cmp(ahi) < 0 {
+		ahi = bhi
+		out.Hi = x.Hi
+	}
+	if alo.Cmp(ahi) > 0 {
+		return nil, false
+	}
+	return out, true
+}
+
+// Hull returns the smallest interval which contains both intervals
+func (v *SlowInterval) Hull(x *SlowInterval) *SlowInterval {
+	assertCompat(v.Lo, x.Lo)
+	alo, ahi := v.bounds()
+	blo, bhi := x.bounds()
+	out := &SlowInterval{Lo: v.Lo, Hi: v.Hi}
+	if blo.Cmp(alo) < 0 {
+		out.Lo = x.Lo
+	}
+	if bhi.Cmp(ahi) > 0 {
+		out.Hi = x.Hi
+	}
+	return out
+}
@@ -0,0 +1,90 @@
+package goposit_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+func p8f(p goposit.Posit8) *big.Float {
+	sp := goposit.NewSlowPosit(8, 0)
+	sp.SetBits(new(big.Int).SetUint64(uint64(p.Bits())))
+	return sp.ToFloat()
+}
+
+// checkEnclosure verifies that the exact value f is in v and that v is as tight as it
+// can be, that is the bounds are the same posit or neighbors.
+func checkEnclosure(t *testing.T, name string, v goposit.Posit8Interval, f *big.Float) {
+	lo, hi := v.Lo(), v.Hi()
+	if (lo.Bits() != 0x80 && p8f(lo).Cmp(f) > 0) || (hi.Bits() != 0x80 && p8f(hi).Cmp(f) < 0) {
+		t.Errorf("%v: [%x, %x] does not contain %v", name, lo.Bits(), hi.Bits(), f.Text('g', 10))
+	}
+	if d := hi.Bits() - lo.Bits(); d > 1 {
+		t.Errorf("%v: [%x, %x] is wider than needed for %v", name, lo.Bits(), hi.Bits(), f.Text('g', 10))
+	}
+}
+
+func TestInterval(t *testing.T) {
+	p := goposit.NewPosit8()
+	point := func(bits uint8) goposit.Posit8Interval {
+		return goposit.NewPosit8Interval(p.SetBits(bits), p.SetBits(bits))
+	}
+	for a := 0; a < 256; a += 3 {
+		for b := 1; b < 256; b += 5 {
+			if a == 0x80 || b == 0x80 {
+				continue
+			}
+			x, y := point(uint8(a)), point(uint8(b))
+			xf, yf := p8f(p.SetBits(uint8(a))), p8f(p.SetBits(uint8(b)))
+			f := new(big.Float).SetPrec(200)
+			checkEnclosure(t, "Add", x.Add(y), f.Add(xf, yf))
+			f = new(big.Float).SetPrec(200)
+			checkEnclosure(t, "Sub", x.Sub(y), f.Sub(xf, yf))
+			f = new(big.Float).SetPrec(200)
+			checkEnclosure(t, "Mul", x.Mul(y), f.Mul(xf, yf))
+			if yf.Sign() != 0 {
+				f = new(big.Float).SetPrec(200)
+				checkEnclosure(t, "Div", x.Div(y), f.Quo(xf, yf))
+			}
+		}
+		if a < 0x80 {
+			f := new(big.Float).SetPrec(200)
+			checkEnclosure(t, "Sqrt", point(uint8(a)).Sqrt(), f.Sqrt(p8f(p.SetBits(uint8(a)))))
+		}
+	}
+
+	one := p.FromInt(1)
+	two := p.FromInt(2)
+	three := p.FromInt(3)
+	v := goposit.NewPosit8Interval(one, three)
+	if !v.Contains(two) || v.Contains(p.FromInt(4)) || v.Contains(p.SetBits(0x80)) {
+		t.Errorf("Contains is wrong")
+	}
+	if v.Width().Bits() != two.Bits() {
+		t.Errorf("Width of [1, 3] should be 2")
+	}
+	if _, ok := goposit.NewPosit8Interval(one, one).Intersect(goposit.NewPosit8Interval(two, three)); ok {
+		t.Errorf("[1, 1] and [2, 3] should not intersect")
+	}
+	i, ok := v.Intersect(goposit.NewPosit8Interval(two, p.FromInt(5)))
+	if !ok || i.Lo().Bits() != two.Bits() || i.Hi().Bits() != three.Bits() {
+		t.Errorf("Intersect of [1, 3] and [2, 5] should be [2, 3]")
+	}
+	h := goposit.NewPosit8Interval(one, one).Hull(goposit.NewPosit8Interval(two, three))
+	if h.Lo().Bits() != one.Bits() || h.Hi().Bits() != three.Bits() {
+		t.Errorf("Hull of [1, 1] and [2, 3] should be [1, 3]")
+	}
+
+	// dividing by an interval containing zero is unbounded
+	d := v.Div(goposit.NewPosit8Interval(p.FromInt(-1), one))
+	if d.Lo().Bits() != 0x80 || d.Hi().Bits() != 0x80 {
+		t.Errorf("Division by an interval containing zero should be unbounded")
+	}
+	// past maxpos the upper bound is unbounded
+	maxpos := goposit.NewPosit8Interval(p.SetBits(0x7f), p.SetBits(0x7f))
+	s := maxpos.Add(maxpos)
+	if s.Lo().Bits() != 0x7f || s.Hi().Bits() != 0x80 {
+		t.Errorf("maxpos + maxpos should be [maxpos, unbounded], got [%x, %x]", s.Lo().Bits(), s.Hi().Bits())
+	}
+}
@@ -0,0 +1,452 @@
+package goposit
+
+import (
+	"errors"
+	"math/big"
+)
+
+// ErrSingular is returned when a matrix cannot be factored because it is singular
+var ErrSingular = errors.New("matrix is singular")
+
+// ErrNotPositiveDefinite is returned by Cholesky when the matrix is not symmetric positive definite
+var ErrNotPositiveDefinite = errors.New("matrix is not positive definite")
+
+// SlowMatrix is a dense row-major matrix of SlowPosits, all of which share the same
+// nbits and es.
+type SlowMatrix struct {
+	rows  int
+	cols  int
+	nbits uint
+	es    uint
+	data  []*SlowPosit
+}
+
+// NewSlowMatrix creates a rows by cols matrix of posits with nbits bits and es exponent
+// bits, every element is initially zero.
+func NewSlowMatrix(rows, cols int, nbits, es uint) *SlowMatrix {
+	m := &SlowMatrix{rows: rows, cols: cols, nbits: nbits, es: es, data: make([]*SlowPosit, rows*cols)}
+	for i := range m.data {
+		m.data[i] = NewSlowPosit(nbits, es)
+	}
+	return m
+}
+
+// Rows returns the number of rows in the matrix
+func (m *SlowMatrix) Rows() int { return m.rows }
+
+// Cols returns the number of columns in the matrix
+func (m *SlowMatrix) Cols() int { return m.cols }
+
+func (m *SlowMatrix) zero() *SlowPosit { return NewSlowPosit(m.nbits, m.es) }
+
+// At returns the element at row i column j
+func (m *SlowMatrix) At(i, j int) *SlowPosit {
+	if i < 0 || i >= m.rows || j < 0 || j >= m.cols {
+		panic("matrix index out of range")
+	}
+	return m.data[i*m.cols+j]
+}
+
+// Set updates the element at row i column j, the posit must have the same size as the matrix
+func (m *SlowMatrix) Set(i, j int, x *SlowPosit) {
+	if i < 0 || i >= m.rows || j < 0 || j >= m.cols {
+		panic("matrix index out of range")
+	}
+	if x.nbits != m.nbits || x.es != m.es {
+		panic("Attempted to put a posit in a matrix with different parameters")
+	}
+	m.data[i*m.cols+j] = x
+}
+
+// Clone makes a copy of the matrix
+func (m *SlowMatrix) Clone() *SlowMatrix {
+	out := &SlowMatrix{rows: m.rows, cols: m.cols, nbits: m.nbits, es: m.es, data: make([]*SlowPosit, len(m.data))}
+	copy(out.data, m.data)
+	return out
+}
+
+// T returns the transpose of the matrix
+func (m *SlowMatrix) T() *SlowMatrix {
+	out := &SlowMatrix{rows: m.cols, cols: m.rows, nbits: m.nbits, es: m.es, data: make([]*SlowPosit, len(m.data))}
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.cols; j++ {
+			out.data[j*out.cols+i] = m.data[i*m.cols+j]
+		}
+	}
+	return out
+}
+
+// Mul returns the matrix product m*x, each multiply and add rounds to nearest even
+func (m *SlowMatrix) Mul(x *SlowMatrix) *SlowMatrix {
+	if m.cols != x.rows {
+		panic("matrix dimensions do not agree")
+	}
+	out := NewSlowMatrix(m.rows, x.cols, m.nbits, m.es)
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < x.cols; j++ {
+			acc := m.zero()
+			for k := 0; k < m.cols; k++ {
+				acc = acc.Add(m.At(i, k).Mul(x.At(k, j)))
+			}
+			out.Set(i, j, acc)
+		}
+	}
+	return out
+}
+
+// MulVec returns the matrix vector product m*x, each multiply and add rounds to nearest even
+func (m *SlowMatrix) MulVec(x []*SlowPosit) []*SlowPosit {
+	if len(x) != m.cols {
+		panic("unexpected length of input")
+	}
+	out := make([]*SlowPosit, m.rows)
+	for i := range out {
+		acc := m.zero()
+		for j, xj := range x {
+			acc = acc.Add(m.At(i, j).Mul(xj))
+		}
+		out[i] = acc
+	}
+	return out
+}
+
+// neg returns -p, negation of a posit is exact
+func (p *SlowPosit) neg() *SlowPosit {
+	out := &SlowPosit{nbits: p.nbits, es: p.es, Bits: new(big.Int).Set(p.Bits)}
+	negate(out.Bits, p.nbits)
+	return out
+}
+
+// cmpAbs compares the magnitudes of two posits
+func cmpAbs(x, y *SlowPosit) int {
+	xf := x.ToFloat()
+	yf := y.ToFloat()
+	return xf.Abs(xf).Cmp(yf.Abs(yf))
+}
+
+func (p *SlowPosit) isZero() bool { return p.Bits.Sign() == 0 }
+
+//// LU ////
+
+// SlowLU is an LU factorization with partial pivoting, P*A = L*U where L is unit lower
+// triangular and U is upper triangular.
+type SlowLU struct {
+	lu  *SlowMatrix
+	piv []int
+}
+
+// LU factors a square matrix using Gaussian elimination with partial pivoting, every
+// operation is done in the posit size of the matrix and rounds to nearest even.
+func (m *SlowMatrix) LU() (*SlowLU, error) {
+	if m.rows != m.cols {
+		panic("LU requires a square matrix")
+	}
+	n := m.rows
+	lu := m.Clone()
+	piv := make([]int, n)
+	for i := range piv {
+		piv[i] = i
+	}
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if cmpAbs(lu.At(i, k), lu.At(p, k)) > 0 {
+				p = i
+			}
+		}
+		if lu.At(p, k).isZero() {
+			return nil, ErrSingular
+		}
+		if p != k {
+			for j := 0; j < n; j++ {
+				a, b := lu.At(k, j), lu.At(p, j)
+				lu.Set(k, j, b)
+				lu.Set(p, j, a)
+			}
+			piv[k], piv[p] = piv[p], piv[k]
+		}
+		pivot := lu.At(k, k)
+		for i := k + 1; i < n; i++ {
+			l := lu.At(i, k).Div(pivot)
+			lu.Set(i, k, l)
+			for j := k + 1; j < n; j++ {
+				lu.Set(i, j, lu.At(i, j).Sub(l.Mul(lu.At(k, j))))
+			}
+		}
+	}
+	return &SlowLU{lu: lu, piv: piv}, nil
+}
+
+// L returns the unit lower triangular factor
+func (f *SlowLU) L() *SlowMatrix {
+	n := f.lu.rows
+	out := NewSlowMatrix(n, n, f.lu.nbits, f.lu.es)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			out.Set(i, j, f.lu.At(i, j))
+		}
+		out.Set(i, i, out.zero().One())
+	}
+	return out
+}
+
+// U returns the upper triangular factor
+func (f *SlowLU) U() *SlowMatrix {
+	n := f.lu.rows
+	out := NewSlowMatrix(n, n, f.lu.nbits, f.lu.es)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			out.Set(i, j, f.lu.At(i, j))
+		}
+	}
+	return out
+}
+
+// Pivot returns the row permutation, row i of P*A is row Pivot()[i] of A
+func (f *SlowLU) Pivot() []int {
+	out := make([]int, len(f.piv))
+	copy(out, f.piv)
+	return out
+}
+
+// Solve solves A*x = b using the factorization
+func (f *SlowLU) Solve(b []*SlowPosit) []*SlowPosit {
+	n := f.lu.rows
+	if len(b) != n {
+		panic("unexpected length of input")
+	}
+	x := make([]*SlowPosit, n)
+	for i := 0; i < n; i++ {
+		x[i] = b[f.piv[i]]
+	}
+	// forward substitution, L has a unit diagonal
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			x[i] = x[i].Sub(f.lu.At(i, j).Mul(x[j]))
+		}
+	}
+	backSubstitute(f.lu, x)
+	return x
+}
+
+// backSubstitute solves u*x = y in place for upper triangular u
+func backSubstitute(u *SlowMatrix, x []*SlowPosit) {
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := i + 1; j < len(x); j++ {
+			x[i] = x[i].Sub(u.At(i, j).Mul(x[j]))
+		}
+		x[i] = x[i].Div(u.At(i, i))
+	}
+}
+
+//// Cholesky ////
+
+// SlowCholesky is a Cholesky factorization A = L*L^T of a symmetric positive definite matrix
+type SlowCholesky struct {
+	l *SlowMatrix
+}
+
+// Cholesky factors a symmetric positive definite matrix, only the lower triangle of the
+// matrix is read.
+func (m *SlowMatrix) Cholesky() (*SlowCholesky, error) {
+	if m.rows != m.cols {
+		panic("Cholesky requires a square matrix")
+	}
+	n := m.rows
+	l := NewSlowMatrix(n, n, m.nbits, m.es)
+	for j := 0; j < n; j++ {
+		d := m.At(j, j)
+		for k := 0; k < j; k++ {
+			d = d.Sub(l.At(j, k).Mul(l.At(j, k)))
+		}
+		if d.isZero() || d.IsNaR() || d.Bits.Bit(d.signBitIdx()) == 1 {
+			return nil, ErrNotPositiveDefinite
+		}
+		d = d.Sqrt()
+		l.Set(j, j, d)
+		for i := j + 1; i < n; i++ {
+			s := m.At(i, j)
+			for k := 0; k < j; k++ {
+				s = s.Sub(l.At(i, k).Mul(l.At(j, k)))
+			}
+			l.Set(i, j, s.Div(d))
+		}
+	}
+	return &SlowCholesky{l: l}, nil
+}
+
+// L returns the lower triangular factor
+func (f *SlowCholesky) L() *SlowMatrix { return f.l.Clone() }
+
+// Solve solves A*x = b using the factorization
+func (f *SlowCholesky) Solve(b []*SlowPosit) []*SlowPosit {
+	n := f.l.rows
+	if len(b) != n {
+		panic("unexpected length of input")
+	}
+	x := make([]*SlowPosit, n)
+	copy(x, b)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			x[i] = x[i].Sub(f.l.At(i, j).Mul(x[j]))
+		}
+		x[i] = x[i].Div(f.l.At(i, i))
+	}
+	backSubstitute(f.l.T(), x)
+	return x
+}
+
+//// QR ////
+
+// SlowQR is a QR factorization A = Q*R computed with Householder reflections, Q is
+// orthogonal and R is upper triangular.
+type SlowQR struct {
+	q *SlowMatrix
+	r *SlowMatrix
+}
+
+// QR factors a matrix with at least as many rows as columns using Householder reflections
+func (m *SlowMatrix) QR() (*SlowQR, error) {
+	if m.rows < m.cols {
+		panic("QR requires at least as many rows as columns")
+	}
+	rows, cols := m.rows, m.cols
+	r := m.Clone()
+	q := NewSlowMatrix(rows, rows, m.nbits, m.es)
+	for i := 0; i < rows; i++ {
+		q.Set(i, i, q.zero().One())
+	}
+	two := m.zero().FromInt(2)
+	for k := 0; k < cols && k < rows-1; k++ {
+		norm := m.zero()
+		for i := k; i < rows; i++ {
+			norm = norm.Add(r.At(i, k).Mul(r.At(i, k)))
+		}
+		if norm.isZero() {
+			continue
+		}
+		alpha := norm.Sqrt()
+		if r.At(k, k).Bits.Bit(r.At(k, k).signBitIdx()) == 0 {
+			alpha = alpha.neg()
+		}
+
+		// v = x - alpha*e1
+		v := make([]*SlowPosit, rows)
+		for i := range v {
+			v[i] = m.zero()
+		}
+		for i := k; i < rows; i++ {
+			v[i] = r.At(i, k)
+		}
+		v[k] = v[k].Sub(alpha)
+		vv := m.zero()
+		for i := k; i < rows; i++ {
+			vv = vv.Add(v[i].Mul(v[i]))
+		}
+		if vv.isZero() {
+			continue
+		}
+
+		// R = H*R, H = I - 2*v*v^T / (v^T*v)
+		for j := 0; j < cols; j++ {
+			s := m.zero()
+			for i := k; i < rows; i++ {
+				s = s.Add(v[i].Mul(r.At(i, j)))
+			}
+			s = s.Mul(two).Div(vv)
+			for i := k; i < rows; i++ {
+				r.Set(i, j, r.At(i, j).Sub(s.Mul(v[i])))
+			}
+		}
+		// Q = Q*H
+		for i := 0; i < rows; i++ {
+			s := m.zero()
+			for j := k; j < rows; j++ {
+				s = s.Add(q.At(i, j).Mul(v[j]))
+			}
+			s = s.Mul(two).Div(vv)
+			for j := k; j < rows; j++ {
+				q.Set(i, j, q.At(i, j).Sub(s.Mul(v[j])))
+			}
+		}
+	}
+	for k := 0; k < cols; k++ {
+		if r.At(k, k).isZero() {
+			return &SlowQR{q: q, r: r}, ErrSingular
+		}
+	}
+	return &SlowQR{q: q, r: r}, nil
+}
+
+// Q returns the orthogonal factor
+func (f *SlowQR) Q() *SlowMatrix { return f.q.Clone() }
+
+// R returns the upper triangular factor
+func (f *SlowQR) R() *SlowMatrix { return f.r.Clone() }
+
+// Solve finds the least squares solution of A*x = b, if A is square this is the exact
+// solution.
+func (f *SlowQR) Solve(b []*SlowPosit) []*SlowPosit {
+	if len(b) != f.q.rows {
+		panic("unexpected length of input")
+	}
+	qtb := f.q.T().MulVec(b)
+	n := f.r.cols
+	x := qtb[:n]
+	backSubstitute(f.r, x)
+	return x
+}
+
+//// Mixed precision ////
+
+// Residual computes b - m*x in the next larger posit size (see Up()), every product is
+// made with MulPromote so it is exact and the sum is accumulated with AddExact, carrying
+// the lost bits in a separate compensation term, so the result is rounded only at the end.
+func (m *SlowMatrix) Residual(b, x []*SlowPosit) []*SlowPosit {
+	if len(x) != m.cols || len(b) != m.rows {
+		panic("unexpected length of input")
+	}
+	out := make([]*SlowPosit, m.rows)
+	for i := range out {
+		sum := b[i].Up()
+		comp := NewSlowPosit(sum.nbits, sum.es)
+		for j, xj := range x {
+			var rem *SlowPosit
+			sum, rem = sum.SubExact(m.At(i, j).MulPromote(xj))
+			comp = comp.Add(rem)
+		}
+		out[i] = sum.Add(comp)
+	}
+	return out
+}
+
+// RefineSolve solves m*x = b by factoring m once with LU in the posit size of the matrix
+// and then running up to iters rounds of iterative refinement, in each round the residual
+// is computed in the next larger posit size (see Residual), rounded back down and used to
+// correct x. Refinement stops early when the correction becomes zero.
+func (m *SlowMatrix) RefineSolve(b []*SlowPosit, iters int) ([]*SlowPosit, error) {
+	lu, err := m.LU()
+	if err != nil {
+		return nil, err
+	}
+	x := lu.Solve(b)
+	for it := 0; it < iters; it++ {
+		r := m.Residual(b, x)
+		rd := make([]*SlowPosit, len(r))
+		for i, ri := range r {
+			rd[i] = ri.Down()
+		}
+		d := lu.Solve(rd)
+		done := true
+		for i, di := range d {
+			if !di.isZero() {
+				done = false
+			}
+			x[i] = x[i].Add(di)
+		}
+		if done {
+			break
+		}
+	}
+	return x, nil
+}
@@ -0,0 +1,148 @@
+package goposit_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+func matrix16(rows [][]int16) goposit.Posit16Matrix {
+	p := goposit.NewPosit16()
+	m := goposit.NewPosit16Matrix(len(rows), len(rows[0]))
+	for i, row := range rows {
+		for j, v := range row {
+			m.Set(i, j, p.FromInt(v))
+		}
+	}
+	return m
+}
+
+func vec16(vals ...int16) []goposit.Posit16 {
+	p := goposit.NewPosit16()
+	out := make([]goposit.Posit16, len(vals))
+	for i, v := range vals {
+		out[i] = p.FromInt(v)
+	}
+	return out
+}
+
+// assertSolution checks that x is within ulps posits of the expected integers
+func assertSolution(t *testing.T, name string, x []goposit.Posit16, err error, ulps int, expect ...int16) {
+	if err != nil {
+		t.Errorf("%v: unexpected error %v", name, err)
+		return
+	}
+	for i, e := range vec16(expect...) {
+		d := int(x[i].Bits()) - int(e.Bits())
+		if d > ulps || d < -ulps {
+			t.Errorf("%v: x[%d] = [%x] expected [%x]", name, i, x[i].Bits(), e.Bits())
+		}
+	}
+}
+
+func TestSolve(t *testing.T) {
+	// symmetric positive definite with solution [1, 2, 3]
+	m := matrix16([][]int16{{4, 1, 2}, {1, 5, 1}, {2, 1, 6}})
+	b := vec16(12, 14, 22)
+
+	x, err := m.SolveLU(b)
+	assertSolution(t, "LU", x, err, 2, 1, 2, 3)
+	x, err = m.SolveCholesky(b)
+	assertSolution(t, "Cholesky", x, err, 2, 1, 2, 3)
+	// QR needs 32 times the tolerance of LU: the errors of Q and R (see TestQR) are carried
+	// into Q^T*b, whose entries are around 20 where a Posit16 has 2 fewer fraction bits than
+	// near 1, and back substitution adds them up into x[0], which ends up 56 posits from 1.
+	x, err = m.SolveQR(b)
+	assertSolution(t, "QR", x, err, 64, 1, 2, 3)
+	x, err = m.RefineSolve(b, 3)
+	assertSolution(t, "RefineSolve", x, err, 0, 1, 2, 3)
+
+	// needs pivoting
+	m = matrix16([][]int16{{0, 1}, {1, 0}})
+	x, err = m.SolveLU(vec16(7, 5))
+	assertSolution(t, "LU pivot", x, err, 0, 5, 7)
+
+	m = matrix16([][]int16{{1, 2}, {2, 4}})
+	if _, err := m.SolveLU(vec16(1, 1)); err != goposit.ErrSingular {
+		t.Errorf("expected singular matrix, got %v", err)
+	}
+	m = matrix16([][]int16{{1, 2}, {2, 1}})
+	if _, err := m.SolveCholesky(vec16(1, 1)); err != goposit.ErrNotPositiveDefinite {
+		t.Errorf("expected not positive definite, got %v", err)
+	}
+}
+
+func TestResidual(t *testing.T) {
+	// the exact solution is not representable, refinement should not make the residual worse
+	m := matrix16([][]int16{{3, 1}, {1, 3}})
+	b := vec16(1, 0)
+	x0, err := m.SolveLU(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x1, err := m.RefineSolve(b, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r0 := m.Residual(b, x0)
+	r1 := m.Residual(b, x1)
+	for i := range r0 {
+		if r1[i].Bits() == 0 {
+			continue
+		}
+		if r0[i].Bits() == 0 || r1[i].Exp() > r0[i].Exp() {
+			t.Errorf("refinement increased the residual [%x] [%x]", r0[i].Bits(), r1[i].Bits())
+		}
+	}
+}
+
+func TestQR(t *testing.T) {
+	rows := [][]float64{{4, 1, 2}, {1, 5, 1}, {2, 1, 6}}
+	a := goposit.NewSlowMatrix(3, 3, 16, 1)
+	for i, row := range rows {
+		for j, v := range row {
+			p := goposit.NewSlowPosit(16, 1)
+			p.FromFloat(big.NewFloat(v), false)
+			a.Set(i, j, p)
+		}
+	}
+	f, err := a.QR()
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, r := f.Q(), f.R()
+
+	// A broken reflection, for example a wrong sign or a missing factor of 2, gives errors
+	// of order 1. The products here are exact so only the rounding of Q and R is measured:
+	// each reflection rounds every element about four times (scale, divide, multiply and
+	// subtract) which leaves Q*R within 8 posits of A and Q orthogonal to about 2**-9.
+	dot := func(i, j int, a, b *goposit.SlowMatrix) *big.Float {
+		sum := new(big.Float).SetPrec(256)
+		for k := 0; k < 3; k++ {
+			sum.Add(sum, new(big.Float).SetPrec(256).Mul(a.At(i, k).ToFloat(), b.At(k, j).ToFloat()))
+		}
+		return sum
+	}
+	qt := q.T()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			p := goposit.NewSlowPosit(16, 1)
+			p.FromFloat(dot(i, j, q, r), false)
+			if d := a.At(i, j).Distance(p); d.CmpAbs(big.NewInt(8)) > 0 {
+				t.Errorf("QR[%d][%d] is %v posits from A", i, j, d)
+			}
+			if j < i && r.At(i, j).ToFloat().Sign() != 0 {
+				t.Errorf("R[%d][%d] should be zero", i, j)
+			}
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if got, _ := dot(i, j, qt, q).Float64(); math.Abs(got-want) > 1.0/256 {
+				t.Errorf("Q^T*Q[%d][%d] = %v, Q should be orthogonal", i, j, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package goposit
+
+import (
+	"math"
+	"math/big"
+)
+
+// PackedArray is an array of posits of any size up to 64 bits stored back to back in a
+// bitstream, so a million 12 bit posits use 1.5MB rather than a *big.Int each. Value i
+// occupies bits i*nbits through (i+1)*nbits-1 of the stream, starting from the least
+// significant bit of the first word, and values may cross from one word into the next.
+type PackedArray struct {
+	nbits uint
+	es    uint
+	n     int
+	words []uint64
+}
+
+// NewPackedArray creates an array of n posits, all zero
+func NewPackedArray(nbits, es uint, n int) *PackedArray {
+	if nbits > 64 {
+		panic("PackedArray supports posits of at most 64 bits")
+	}
+	if n < 0 {
+		panic("PackedArray length must not be negative")
+	}
+	NewSlowPosit(nbits, es)
+	return &PackedArray{nbits: nbits, es: es, n: n, words: make([]uint64, (uint(n)*nbits+63)/64)}
+}
+
+// Nbits returns the size of the posits in the array
+func (a *PackedArray) Nbits() uint { return a.nbits }
+
+// Es returns the number of exponent bits of the posits in the array
+func (a *PackedArray) Es() uint { return a.es }
+
+// Len returns the number of posits in the array
+func (a *PackedArray) Len() int { return a.n }
+
+// Words returns the bitstream which backs the array, it is not a copy
+func (a *PackedArray) Words() []uint64 { return a.words }
+
+func (a *PackedArray) mask() uint64 { return ^uint64(0) >> (64 - a.nbits) }
+
+// Bits returns the raw bits of posit i
+func (a *PackedArray) Bits(i int) uint64 {
+	if i < 0 || i >= a.n {
+		panic("PackedArray index out of range")
+	}
+	off := uint(i) * a.nbits
+	w, s := off/64, off%64
+	v := a.words[w] >> s
+	if s+a.nbits > 64 {
+		v |= a.words[w+1] << (64 - s)
+	}
+	return v & a.mask()
+}
+
+// SetBits sets the raw bits of posit i, bits above nbits are ignored
+func (a *PackedArray) SetBits(i int, bits uint64) {
+	if i < 0 || i >= a.n {
+		panic("PackedArray index out of range")
+	}
+	bits &= a.mask()
+	off := uint(i) * a.nbits
+	w, s := off/64, off%64
+	a.words[w] = a.words[w]&^(a.mask()<<s) | bits<<s
+	if s+a.nbits > 64 {
+		a.words[w+1] = a.words[w+1]&^(a.mask()>>(64-s)) | bits>>(64-s)
+	}
+}
+
+// Get returns posit i as a new SlowPosit
+func (a *PackedArray) Get(i int) *SlowPosit {
+	out := NewSlowPosit(a.nbits, a.es)
+	out.Bits.SetUint64(a.Bits(i))
+	return out
+}
+
+// Set stores p as posit i, p must be the same size as the array
+func (a *PackedArray) Set(i int, p *SlowPosit) {
+	if p.nbits != a.nbits || p.es != a.es {
+		panic("posit is not the same size as the PackedArray")
+	}
+	a.SetBits(i, p.Uint64())
+}
+
+// Range calls f with each index and posit in order until f returns false
+func (a *PackedArray) Range(f func(i int, p *SlowPosit) bool) {
+	for i := 0; i < a.n; i++ {
+		if !f(i, a.Get(i)) {
+			return
+		}
+	}
+}
+
+// Float64s returns every posit converted to float64 rounding to nearest even, NaR becomes NaN
+func (a *PackedArray) Float64s() []float64 {
+	out := make([]float64, a.n)
+	p := NewSlowPosit(a.nbits, a.es)
+	for i := range out {
+		p.Bits.SetUint64(a.Bits(i))
+		if p.IsNaR() {
+			out[i] = math.NaN()
+		} else {
+			out[i], _ = p.ToFloat().Float64()
+		}
+	}
+	return out
+}
+
+// SetFloat64s converts the floats to posits rounding to nearest even and stores them
+// starting at index 0, NaN and Inf become NaR.
+func (a *PackedArray) SetFloat64s(x []float64) {
+	if len(x) > a.n {
+		panic("more floats than fit in the PackedArray")
+	}
+	p := NewSlowPosit(a.nbits, a.es)
+	f := new(big.Float)
+	for i, v := range x {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			p.NaR()
+		} else {
+			p.FromFloat(f.SetFloat64(v), false)
+		}
+		a.SetBits(i, p.Uint64())
+	}
+}
@@ -0,0 +1,69 @@
+package goposit_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+func TestPackedArray(t *testing.T) {
+	for _, nbits := range []uint{5, 6, 12, 24, 63, 64} {
+		const n = 300
+		a := goposit.NewPackedArray(nbits, 1, n)
+		if len(a.Words()) != int((n*nbits+63)/64) {
+			t.Errorf("%d bits: expected %d words got %d", nbits, (n*nbits+63)/64, len(a.Words()))
+		}
+		// fill with a pattern and then overwrite every third value so that neighbors which
+		// share a word must not be disturbed
+		expect := make([]uint64, n)
+		seed := uint64(nbits)
+		for pass := 0; pass < 2; pass++ {
+			for i := pass; i < n; i += 1 + 2*pass {
+				seed = seed*6364136223846793005 + 1442695040888963407
+				expect[i] = seed >> (64 - nbits)
+				a.SetBits(i, expect[i])
+			}
+		}
+		a.Range(func(i int, p *goposit.SlowPosit) bool {
+			if p.Uint64() != expect[i] || p.Nbits() != nbits {
+				t.Fatalf("%d bits: index %d expected %x got %x", nbits, i, expect[i], p.Uint64())
+			}
+			return true
+		})
+	}
+
+	a := goposit.NewPackedArray(12, 1, 5)
+	a.SetFloat64s([]float64{1, -2.5, math.NaN(), 1e-30, 3})
+	f := a.Float64s()
+	if f[0] != 1 || f[1] != -2.5 || !math.IsNaN(f[2]) || f[4] != 3 {
+		t.Errorf("float64 round trip failed: %v", f)
+	}
+	// 1e-30 is below minpos so it rounds up to minpos rather than zero
+	if a.Bits(3) != 1 {
+		t.Errorf("1e-30 should round to minpos, got %x", a.Bits(3))
+	}
+	p := goposit.NewSlowPosit(12, 1)
+	p.FromFloat(big.NewFloat(0.75), false)
+	a.Set(0, p)
+	if a.Get(0).Uint64() != p.Uint64() || a.Get(1).Uint64() != a.Bits(1) {
+		t.Errorf("Get/Set failed")
+	}
+
+	count := 0
+	a.Range(func(i int, p *goposit.SlowPosit) bool {
+		count++
+		return i < 1
+	})
+	if count != 2 {
+		t.Errorf("Range should stop when f returns false, called %d times", count)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("a negative length should panic")
+		}
+	}()
+	goposit.NewPackedArray(12, 1, -1)
+}
@@ -1,5 +1,9 @@
 package goposit
 
+import (
+	"math/big"
+)
+
 // Posit128 is a holding place for a 128 bit result of a Posit64.MulPromote() or
 // Posit64.DivPromote, it does not implement all of the features, just enough to
 // break it down.
@@ -14,3 +18,9 @@ func (p Posit128) Exp() int32 {
 // Mant outputs a posit with the same mantissa but an exponent of 0, that is a
 // which is greater than or equal to 0.5 and less than 1
 func (p Posit128) Mant() Posit128 { return Posit128{impl: p.impl.Mant()} }
+
+// Rat returns the exact value of the posit, or nil if it is NaR
+func (p Posit128) Rat() *big.Rat { return p.impl.Rat() }
+
+// BigFloat returns the exact value of the posit, or nil if it is NaR
+func (p Posit128) BigFloat() *big.Float { return p.impl.BigFloat() }
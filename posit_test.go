@@ -146,44 +146,6 @@ func TestSimple(t *testing.T) {
 	simpleTestCase(t, goposit.NewSlowPosit(128, 4))
 }
 
-const bisectDepth = 40
-
-func bisect(t *testing.T, p *goposit.SlowPosit, lesser, greater *big.Float, lesserP, greaterP *big.Int) {
-	diff := big.NewFloat(0).Sub(greater, lesser)
-	ex := diff.MantExp(nil)
-	oneToThe := big.NewFloat(1)
-	oneToThe = oneToThe.SetMantExp(oneToThe, ex+1)
-
-	//fmt.Printf("%v %v\n", diff, oneToThe)
-
-	middle := big.NewFloat(0).Copy(lesser)
-	middle.Add(middle, oneToThe)
-	if middle.Cmp(greater) <= 0 {
-		panic("bad test, wrong exponent")
-	}
-	middle.Sub(middle, oneToThe)
-	if middle.Acc() != big.Exact {
-		t.Errorf("Not enough precision")
-		return
-	}
-
-	smallestFind := big.NewFloat(0).SetInf(true)
-	for i := 0; i < bisectDepth; i++ {
-		middle.Add(middle, oneToThe)
-		i2, _ := f2p(t, p, middle)
-		if i2.Cmp(lesserP) > 0 {
-			if i2.Cmp(greaterP) > 0 {
-				// too high
-			} else {
-				smallestFind.Copy(middle)
-			}
-			middle.Sub(middle, oneToThe)
-		}
-		goposit.Shf(oneToThe, -1)
-	}
-	//fmt.Printf("Smallest: %v\n", smallestFind)
-}
-
 func roundingTest(t *testing.T, p *goposit.SlowPosit, hexmin, hexmax string) {
 	i0, s := big.NewInt(0).SetString(hexmin, 16)
 	assert(s)
@@ -275,3 +237,16 @@ func TestRounding(t *testing.T) {
 	roundingTest(t, goposit.NewSlowPosit(128, 4), "80000000000000000000000000000001", "8000000000000000000000000000000f")
 	roundingTest(t, goposit.NewSlowPosit(128, 4), "fffffffffffffffffffffffffffffff0", "ffffffffffffffffffffffffffffffff")
 }
+
+func TestWideRoundTrip(t *testing.T) {
+	// posits wider than a machine word must decode their words in the right order
+	for _, c := range [][2]uint{{65, 3}, {100, 5}, {128, 4}, {200, 2}} {
+		for _, f := range []float64{0.3, -0.3, 1.0 / 3, 12345.678, -1e-30} {
+			p := goposit.NewSlowPosit(c[0], c[1])
+			in := big.NewFloat(f)
+			if !p.FromFloat(in, false) || p.ToFloat().Cmp(in) != 0 {
+				t.Errorf("%d bit es %d: %v became %v", c[0], c[1], f, p.ToFloat())
+			}
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package goposit
+
+import (
+	"math/big"
+	"math/bits"
+	"math/rand/v2"
+)
+
+// Rand generates random posits from a math/rand/v2 Source, so a sequence can be
+// reproduced by seeding the source. Like rand.Rand it is not safe for concurrent use
+// unless the source is.
+type Rand struct {
+	src rand.Source
+}
+
+// NewRand returns a Rand which takes its random bits from src
+func NewRand(src rand.Source) *Rand {
+	return &Rand{src: src}
+}
+
+// randInt returns a uniformly distributed integer of n bits
+func (r *Rand) randInt(n uint) *big.Int {
+	out := new(big.Int)
+	for i := uint(0); i < n; i += 64 {
+		w, size := r.src.Uint64(), min(n-i, 64)
+		out.Lsh(out, size)
+		out.Or(out, new(big.Int).SetUint64(w>>(64-size)))
+	}
+	return out
+}
+
+// SlowPositBits returns a posit with every bit pattern equally likely, including NaR
+func (r *Rand) SlowPositBits(nbits, es uint) *SlowPosit {
+	p := NewSlowPosit(nbits, es)
+	p.Bits = r.randInt(nbits)
+	return p
+}
+
+// SlowPosit returns a uniformly distributed real number in [0, 1) correctly rounded to
+// the posit, so each posit is returned with the probability of the interval which rounds
+// to it. Numbers close to 1 may round up to 1 and, because posits do not underflow,
+// numbers smaller than minpos round up to minpos.
+func (r *Rand) SlowPosit(nbits, es uint) *SlowPosit {
+	p := NewSlowPosit(nbits, es)
+
+	// the number is in [2**-(k+1), 2**-k) with probability 2**-(k+1), where k is the
+	// number of leading zero bits
+	k := 0
+	for {
+		w := r.src.Uint64()
+		k += bits.LeadingZeros64(w)
+		if w != 0 || k >= p.Log2MaxVal() {
+			break
+		}
+	}
+	if k >= p.Log2MaxVal() {
+		// smaller than minpos
+		return p.Min()
+	}
+
+	// Every rounding boundary of the posit has fewer than nbits significant bits so nbits+2
+	// random bits below the leading one and a sticky bit standing for the rest of the
+	// infinitely long number round the same way as the number itself.
+	m := r.randInt(nbits + 2)
+	m.SetBit(m, int(nbits+2), 1)
+	m.Lsh(m, 1)
+	m.SetBit(m, 0, 1)
+	f := new(big.Float).SetPrec(nbits + 4).SetInt(m)
+	f.SetMantExp(f, -(k+1)-int(nbits+3))
+	p.FromFloat(f, false)
+	return p
+}
+
+// uniform returns a uniformly distributed number in (0, 1] with prec bits
+func (r *Rand) uniform(prec uint) *big.Float {
+	m := r.randInt(prec)
+	m.Add(m, bigi1)
+	f := newBig(prec + 1).SetInt(m)
+	return f.SetMantExp(f, -int(prec))
+}
+
+// ExpSlowPosit returns an exponentially distributed number with rate 1 (mean 1), the
+// number is computed in high precision and rounded to nearest even.
+func (r *Rand) ExpSlowPosit(nbits, es uint) *SlowPosit {
+	p := NewSlowPosit(nbits, es)
+	prec := p.hiPrec()
+	e := BigLog(r.uniform(prec), prec)
+	p.FromFloat(e.Neg(e), false)
+	return p
+}
+
+// NormSlowPosit returns a normally distributed number with mean 0 and standard deviation
+// 1, made with the Box-Muller transform in high precision and rounded to nearest even.
+func (r *Rand) NormSlowPosit(nbits, es uint) *SlowPosit {
+	p := NewSlowPosit(nbits, es)
+	prec := p.hiPrec()
+
+	// sqrt(-2 ln u1) * cos(2 pi u2)
+	l := BigLog(r.uniform(prec), prec)
+	Shf(l, 1)
+	radius := newBig(prec).Sqrt(l.Neg(l))
+	theta := BigPi(prec)
+	Shf(theta, 1)
+	theta.Mul(theta, r.uniform(prec))
+	_, cos := BigSinCos(theta, prec)
+	p.FromFloat(cos.Mul(cos, radius), false)
+	return p
+}
@@ -0,0 +1,106 @@
+package goposit_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+func TestRandReproducible(t *testing.T) {
+	a := goposit.NewRand(rand.NewPCG(1, 2))
+	b := goposit.NewRand(rand.NewPCG(1, 2))
+	for i := 0; i < 100; i++ {
+		if a.Posit32().Bits() != b.Posit32().Bits() || a.NormPosit16().Bits() != b.NormPosit16().Bits() ||
+			a.SlowPositBits(100, 5).Bits.Cmp(b.SlowPositBits(100, 5).Bits) != 0 {
+			t.Fatal("the same seed should give the same posits")
+		}
+	}
+}
+
+func TestRandBits(t *testing.T) {
+	r := goposit.NewRand(rand.NewPCG(3, 4))
+	var counts [256]int
+	for i := 0; i < 256*100; i++ {
+		counts[r.Posit8Bits().Bits()]++
+	}
+	for bits, n := range counts {
+		if n < 50 || n > 160 {
+			t.Errorf("bits %02x came %d times, expected about 100", bits, n)
+		}
+	}
+	for i := 0; i < 100; i++ {
+		if p := r.SlowPositBits(70, 2); p.Bits.BitLen() > 70 {
+			t.Fatalf("too many bits %v", p.Bits)
+		}
+	}
+}
+
+func TestRandUniform(t *testing.T) {
+	// the reals which round to a posit are between the two 9 bit posits on either side of it
+	half := func(bits int) float64 {
+		p := goposit.NewSlowPosit(9, 0)
+		p.SetBits(big.NewInt(int64(bits)))
+		f, _ := p.ToFloat().Float64()
+		return f
+	}
+	const n = 100000
+	r := goposit.NewRand(rand.NewPCG(5, 6))
+	var counts [256]int
+	for i := 0; i < n; i++ {
+		counts[r.Posit8().Bits()]++
+	}
+	for bits := 1; bits <= 0x40; bits++ {
+		lo, hi := math.Max(half(2*bits-1), 0), math.Min(half(2*bits+1), 1)
+		if bits == 1 {
+			lo = 0
+		}
+		want := n * (hi - lo)
+		if got := float64(counts[bits]); math.Abs(got-want) > 5*math.Sqrt(want)+5 {
+			t.Errorf("posit %02x came %v times, expected %v", bits, got, want)
+		}
+		counts[bits] = 0
+	}
+	for bits, c := range counts {
+		if c != 0 {
+			t.Errorf("posit %02x is not in [0, 1] but came %d times", bits, c)
+		}
+	}
+
+	sum := 0.0
+	for i := 0; i < 10000; i++ {
+		p := r.SlowPosit(100, 5)
+		f, _ := p.ToFloat().Float64()
+		if f <= 0 || f >= 1 {
+			t.Fatalf("%v is not in (0, 1)", f)
+		}
+		sum += f
+	}
+	if mean := sum / 10000; math.Abs(mean-0.5) > 0.02 {
+		t.Errorf("mean should be 0.5 got %v", mean)
+	}
+}
+
+func TestRandDistributions(t *testing.T) {
+	r := goposit.NewRand(rand.NewPCG(7, 8))
+	const n = 5000
+	var sum, sq, esum float64
+	for i := 0; i < n; i++ {
+		x := f32(r.NormPosit32())
+		sum += x
+		sq += x * x
+		e := f32(r.ExpPosit32())
+		if e < 0 {
+			t.Fatalf("exponential variate %v is negative", e)
+		}
+		esum += e
+	}
+	if mean, v := sum/n, sq/n; math.Abs(mean) > 0.05 || math.Abs(v-1) > 0.08 {
+		t.Errorf("normal mean %v variance %v", mean, v)
+	}
+	if mean := esum / n; math.Abs(mean-1) > 0.05 {
+		t.Errorf("exponential mean %v", mean)
+	}
+}
@@ -0,0 +1,13 @@
+
+__COMMENT__ ONE_T returns a uniformly distributed real number in [0, 1) correctly rounded to a ONE_T,
+__COMMENT__ see SlowPosit
+func (r *Rand) ONE_T() ONE_T { return ONE_T{impl: r.SlowPosit(NBITS, ES)} }
+
+__COMMENT__ GLUE(ONE_T, Bits) returns a ONE_T with every bit pattern equally likely, including NaR
+func (r *Rand) GLUE(ONE_T, Bits)() ONE_T { return ONE_T{impl: r.SlowPositBits(NBITS, ES)} }
+
+__COMMENT__ GLUE(Exp, ONE_T) returns an exponentially distributed ONE_T with rate 1
+func (r *Rand) GLUE(Exp, ONE_T)() ONE_T { return ONE_T{impl: r.ExpSlowPosit(NBITS, ES)} }
+
+__COMMENT__ GLUE(Norm, ONE_T) returns a normally distributed ONE_T with mean 0 and standard deviation 1
+func (r *Rand) GLUE(Norm, ONE_T)() ONE_T { return ONE_T{impl: r.NormSlowPosit(NBITS, ES)} }
@@ -0,0 +1,53 @@
+package goposit
+
+// Posit8 returns a uniformly distributed real number in [0, 1) correctly rounded to a Posit8,
+// see SlowPosit
+func (r *Rand) Posit8() Posit8 { return Posit8{impl: r.SlowPosit(8, 0)} }
+
+// Posit8Bits returns a Posit8 with every bit pattern equally likely, including NaR
+func (r *Rand) Posit8Bits() Posit8 { return Posit8{impl: r.SlowPositBits(8, 0)} }
+
+// ExpPosit8 returns an exponentially distributed Posit8 with rate 1
+func (r *Rand) ExpPosit8() Posit8 { return Posit8{impl: r.ExpSlowPosit(8, 0)} }
+
+// NormPosit8 returns a normally distributed Posit8 with mean 0 and standard deviation 1
+func (r *Rand) NormPosit8() Posit8 { return Posit8{impl: r.NormSlowPosit(8, 0)} }
+
+// Posit16 returns a uniformly distributed real number in [0, 1) correctly rounded to a Posit16,
+// see SlowPosit
+func (r *Rand) Posit16() Posit16 { return Posit16{impl: r.SlowPosit(16, 1)} }
+
+// Posit16Bits returns a Posit16 with every bit pattern equally likely, including NaR
+func (r *Rand) Posit16Bits() Posit16 { return Posit16{impl: r.SlowPositBits(16, 1)} }
+
+// ExpPosit16 returns an exponentially distributed Posit16 with rate 1
+func (r *Rand) ExpPosit16() Posit16 { return Posit16{impl: r.ExpSlowPosit(16, 1)} }
+
+// NormPosit16 returns a normally distributed Posit16 with mean 0 and standard deviation 1
+func (r *Rand) NormPosit16() Posit16 { return Posit16{impl: r.NormSlowPosit(16, 1)} }
+
+// Posit32 returns a uniformly distributed real number in [0, 1) correctly rounded to a Posit32,
+// see SlowPosit
+func (r *Rand) Posit32() Posit32 { return Posit32{impl: r.SlowPosit(32, 2)} }
+
+// Posit32Bits returns a Posit32 with every bit pattern equally likely, including NaR
+func (r *Rand) Posit32Bits() Posit32 { return Posit32{impl: r.SlowPositBits(32, 2)} }
+
+// ExpPosit32 returns an exponentially distributed Posit32 with rate 1
+func (r *Rand) ExpPosit32() Posit32 { return Posit32{impl: r.ExpSlowPosit(32, 2)} }
+
+// NormPosit32 returns a normally distributed Posit32 with mean 0 and standard deviation 1
+func (r *Rand) NormPosit32() Posit32 { return Posit32{impl: r.NormSlowPosit(32, 2)} }
+
+// Posit64 returns a uniformly distributed real number in [0, 1) correctly rounded to a Posit64,
+// see SlowPosit
+func (r *Rand) Posit64() Posit64 { return Posit64{impl: r.SlowPosit(64, 3)} }
+
+// Posit64Bits returns a Posit64 with every bit pattern equally likely, including NaR
+func (r *Rand) Posit64Bits() Posit64 { return Posit64{impl: r.SlowPositBits(64, 3)} }
+
+// ExpPosit64 returns an exponentially distributed Posit64 with rate 1
+func (r *Rand) ExpPosit64() Posit64 { return Posit64{impl: r.ExpSlowPosit(64, 3)} }
+
+// NormPosit64 returns a normally distributed Posit64 with mean 0 and standard deviation 1
+func (r *Rand) NormPosit64() Posit64 { return Posit64{impl: r.NormSlowPosit(64, 3)} }
@@ -0,0 +1,56 @@
+
+__COMMENT__ CPLX_T is a complex number made of two ONE_T
+type CPLX_T struct{ impl *SlowComplex }
+
+__COMMENT__ GLUE(New, CPLX_T) makes a new complex number from a real and imaginary part
+func GLUE(New, CPLX_T)(re, im ONE_T) CPLX_T {
+    return CPLX_T{impl: NewSlowComplex(re.impl, im.impl)}
+}
+
+__COMMENT__ GLUE(GLUE(New, CPLX_T), Polar) makes a new complex number from an absolute value
+__COMMENT__ and a phase angle in radians, each part is computed in high precision and rounded to nearest even
+func GLUE(GLUE(New, CPLX_T), Polar)(r, theta ONE_T) CPLX_T {
+    return CPLX_T{impl: NewSlowComplexPolar(r.impl, theta.impl)}
+}
+
+__COMMENT__ Real returns the real part
+func (z CPLX_T) Real() ONE_T { return ONE_T{impl: z.impl.Re} }
+
+__COMMENT__ Imag returns the imaginary part
+func (z CPLX_T) Imag() ONE_T { return ONE_T{impl: z.impl.Im} }
+
+__COMMENT__ Add takes the sum of two complex numbers, each part rounds to nearest even
+func (z CPLX_T) Add(x CPLX_T) CPLX_T { return CPLX_T{impl: z.impl.Add(x.impl)} }
+
+__COMMENT__ Sub takes the difference of two complex numbers, each part rounds to nearest even
+func (z CPLX_T) Sub(x CPLX_T) CPLX_T { return CPLX_T{impl: z.impl.Sub(x.impl)} }
+
+__COMMENT__ Mul takes the product of two complex numbers, the partial products are made with
+__COMMENT__ MulPromote so they are exact and each part of the result is rounded only once.
+func (z CPLX_T) Mul(x CPLX_T) CPLX_T { return CPLX_T{impl: z.impl.Mul(x.impl)} }
+
+__COMMENT__ Div takes the quotent of two complex numbers, each part of the result is rounded
+__COMMENT__ only once. Division by zero results in NaR.
+func (z CPLX_T) Div(x CPLX_T) CPLX_T { return CPLX_T{impl: z.impl.Div(x.impl)} }
+
+__COMMENT__ Conj returns the complex conjugate, this is always exact
+func (z CPLX_T) Conj() CPLX_T { return CPLX_T{impl: z.impl.Conj()} }
+
+__COMMENT__ Abs returns the absolute value of z, rounded only once
+func (z CPLX_T) Abs() ONE_T { return ONE_T{impl: z.impl.Abs()} }
+
+__COMMENT__ Phase returns the argument of z in radians, between -pi and pi
+func (z CPLX_T) Phase() ONE_T { return ONE_T{impl: z.impl.Phase()} }
+
+__COMMENT__ Polar returns the absolute value and the phase of z
+func (z CPLX_T) Polar() (ONE_T, ONE_T) {
+    r, theta := z.impl.Polar()
+    return ONE_T{impl: r}, ONE_T{impl: theta}
+}
+
+__COMMENT__ Sqrt returns the principal square root of z, each part is computed in high precision
+__COMMENT__ and rounded to nearest even.
+func (z CPLX_T) Sqrt() CPLX_T { return CPLX_T{impl: z.impl.Sqrt()} }
+
+__COMMENT__ Exp returns e**z, each part is computed in high precision and rounded to nearest even
+func (z CPLX_T) Exp() CPLX_T { return CPLX_T{impl: z.impl.Exp()} }
@@ -0,0 +1,113 @@
+package goposit
+
+// Complex32 is a complex number made of two Posit16
+type Complex32 struct{ impl *SlowComplex }
+
+// NewComplex32 makes a new complex number from a real and imaginary part
+func NewComplex32(re, im Posit16) Complex32 {
+	return Complex32{impl: NewSlowComplex(re.impl, im.impl)}
+}
+
+// NewComplex32Polar makes a new complex number from an absolute value
+// and a phase angle in radians, each part is computed in high precision and rounded to nearest even
+func NewComplex32Polar(r, theta Posit16) Complex32 {
+	return Complex32{impl: NewSlowComplexPolar(r.impl, theta.impl)}
+}
+
+// Real returns the real part
+func (z Complex32) Real() Posit16 { return Posit16{impl: z.impl.Re} }
+
+// Imag returns the imaginary part
+func (z Complex32) Imag() Posit16 { return Posit16{impl: z.impl.Im} }
+
+// Add takes the sum of two complex numbers, each part rounds to nearest even
+func (z Complex32) Add(x Complex32) Complex32 { return Complex32{impl: z.impl.Add(x.impl)} }
+
+// Sub takes the difference of two complex numbers, each part rounds to nearest even
+func (z Complex32) Sub(x Complex32) Complex32 { return Complex32{impl: z.impl.Sub(x.impl)} }
+
+// Mul takes the product of two complex numbers, the partial products are made with
+// MulPromote so they are exact and each part of the result is rounded only once.
+func (z Complex32) Mul(x Complex32) Complex32 { return Complex32{impl: z.impl.Mul(x.impl)} }
+
+// Div takes the quotent of two complex numbers, each part of the result is rounded
+// only once. Division by zero results in NaR.
+func (z Complex32) Div(x Complex32) Complex32 { return Complex32{impl: z.impl.Div(x.impl)} }
+
+// Conj returns the complex conjugate, this is always exact
+func (z Complex32) Conj() Complex32 { return Complex32{impl: z.impl.Conj()} }
+
+// Abs returns the absolute value of z, rounded only once
+func (z Complex32) Abs() Posit16 { return Posit16{impl: z.impl.Abs()} }
+
+// Phase returns the argument of z in radians, between -pi and pi
+func (z Complex32) Phase() Posit16 { return Posit16{impl: z.impl.Phase()} }
+
+// Polar returns the absolute value and the phase of z
+func (z Complex32) Polar() (Posit16, Posit16) {
+	r, theta := z.impl.Polar()
+	return Posit16{impl: r}, Posit16{impl: theta}
+}
+
+// Sqrt returns the principal square root of z, each part is computed in high precision
+// and rounded to nearest even.
+func (z Complex32) Sqrt() Complex32 { return Complex32{impl: z.impl.Sqrt()} }
+
+// Exp returns e**z, each part is computed in high precision and rounded to nearest even
+func (z Complex32) Exp() Complex32 { return Complex32{impl: z.impl.Exp()} }
+
+// Complex64 is a complex number made of two Posit32
+type Complex64 struct{ impl *SlowComplex }
+
+// NewComplex64 makes a new complex number from a real and imaginary part
+func NewComplex64(re, im Posit32) Complex64 {
+	return Complex64{impl: NewSlowComplex(re.impl, im.impl)}
+}
+
+// NewComplex64Polar makes a new complex number from an absolute value
+// and a phase angle in radians, each part is computed in high precision and rounded to nearest even
+func NewComplex64Polar(r, theta Posit32) Complex64 {
+	return Complex64{impl: NewSlowComplexPolar(r.impl, theta.impl)}
+}
+
+// Real returns the real part
+func (z Complex64) Real() Posit32 { return Posit32{impl: z.impl.Re} }
+
+// Imag returns the imaginary part
+func (z Complex64) Imag() Posit32 { return Posit32{impl: z.impl.Im} }
+
+// Add takes the sum of two complex numbers, each part rounds to nearest even
+func (z Complex64) Add(x Complex64) Complex64 { return Complex64{impl: z.impl.Add(x.impl)} }
+
+// Sub takes the difference of two complex numbers, each part rounds to nearest even
+func (z Complex64) Sub(x Complex64) Complex64 { return Complex64{impl: z.impl.Sub(x.impl)} }
+
+// Mul takes the product of two complex numbers, the partial products are made with
+// MulPromote so they are exact and each part of the result is rounded only once.
+func (z Complex64) Mul(x Complex64) Complex64 { return Complex64{impl: z.impl.Mul(x.impl)} }
+
+// Div takes the quotent of two complex numbers, each part of the result is rounded
+// only once. Division by zero results in NaR.
+func (z Complex64) Div(x Complex64) Complex64 { return Complex64{impl: z.impl.Div(x.impl)} }
+
+// Conj returns the complex conjugate, this is always exact
+func (z Complex64) Conj() Complex64 { return Complex64{impl: z.impl.Conj()} }
+
+// Abs returns the absolute value of z, rounded only once
+func (z Complex64) Abs() Posit32 { return Posit32{impl: z.impl.Abs()} }
+
+// Phase returns the argument of z in radians, between -pi and pi
+func (z Complex64) Phase() Posit32 { return Posit32{impl: z.impl.Phase()} }
+
+// Polar returns the absolute value and the phase of z
+func (z Complex64) Polar() (Posit32, Posit32) {
+	r, theta := z.impl.Polar()
+	return Posit32{impl: r}, Posit32{impl: theta}
+}
+
+// Sqrt returns the principal square root of z, each part is computed in high precision
+// and rounded to nearest even.
+func (z Complex64) Sqrt() Complex64 { return Complex64{impl: z.impl.Sqrt()} }
+
+// Exp returns e**z, each part is computed in high precision and rounded to nearest even
+func (z Complex64) Exp() Complex64 { return Complex64{impl: z.impl.Exp()} }
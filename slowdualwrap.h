@@ -0,0 +1,124 @@
+#define DUAL_T GLUE(ONE_T, Dual)
+#define TAPE_T GLUE(ONE_T, Tape)
+#define VAR_T GLUE(ONE_T, Var)
+
+__COMMENT__ DUAL_T is a dual number of ONE_T for forward mode differentiation, see SlowDual
+type DUAL_T struct{ impl *SlowDual }
+
+__COMMENT__ GLUE(New, DUAL_T) makes the dual number val + der*e, use der = 1 for the variable
+__COMMENT__ which is being differentiated
+func GLUE(New, DUAL_T)(val, der ONE_T) DUAL_T { return DUAL_T{impl: NewSlowDual(val.impl, der.impl)} }
+
+__COMMENT__ Val returns the value
+func (z DUAL_T) Val() ONE_T { return ONE_T{impl: z.impl.Val} }
+
+__COMMENT__ Der returns the derivative
+func (z DUAL_T) Der() ONE_T { return ONE_T{impl: z.impl.Der} }
+
+#define BINARY(_NAME_, _DOC_) \
+    __COMMENT__ _NAME_ returns _DOC_ __NEWLINE__\
+    func (z DUAL_T) _NAME_(x DUAL_T) DUAL_T { return DUAL_T{impl: z.impl._NAME_(x.impl)} }
+
+#define UNARY(_NAME_, _DOC_) \
+    __COMMENT__ _NAME_ returns _DOC_ __NEWLINE__\
+    func (z DUAL_T) _NAME_() DUAL_T { return DUAL_T{impl: z.impl._NAME_()} }
+
+BINARY(Add, z + x)
+
+BINARY(Sub, z - x)
+
+BINARY(Mul, z * x with the derivative rounded once)
+
+BINARY(Div, z / x with the derivative rounded once)
+
+UNARY(Neg, -z)
+
+UNARY(Sqrt, the square root of z)
+
+UNARY(Exp, e**z)
+
+UNARY(Log, the natural logarithm of z)
+
+UNARY(Sin, the sine of z)
+
+UNARY(Cos, the cosine of z)
+
+UNARY(Tanh, the hyperbolic tangent of z)
+
+UNARY(Atan, the arc tangent of z)
+
+#undef BINARY
+#undef UNARY
+
+__COMMENT__ TAPE_T records operations on VAR_T for reverse mode differentiation, see SlowTape
+type TAPE_T struct{ impl *SlowTape }
+
+__COMMENT__ VAR_T is a value on a TAPE_T
+type VAR_T struct{ impl *SlowVar }
+
+__COMMENT__ GLUE(New, TAPE_T) creates an empty tape
+func GLUE(New, TAPE_T)() TAPE_T { return TAPE_T{impl: NewSlowTape()} }
+
+__COMMENT__ Var adds an input to the tape
+func (t TAPE_T) Var(x ONE_T) VAR_T { return VAR_T{impl: t.impl.Var(x.impl)} }
+
+__COMMENT__ Len returns the number of values on the tape
+func (t TAPE_T) Len() int { return t.impl.Len() }
+
+__COMMENT__ Reset removes every value from the tape
+func (t TAPE_T) Reset() { t.impl.Reset() }
+
+__COMMENT__ Grad returns the derivative of out with respect to each of wrt
+func (t TAPE_T) Grad(out VAR_T, wrt ...VAR_T) []ONE_T {
+    vars := make([]*SlowVar, len(wrt))
+    for i, w := range wrt {
+        vars[i] = w.impl
+    }
+    grad := t.impl.Grad(out.impl, vars...)
+    res := make([]ONE_T, len(grad))
+    for i, g := range grad {
+        res[i] = ONE_T{impl: g}
+    }
+    return res
+}
+
+__COMMENT__ Value returns the value
+func (v VAR_T) Value() ONE_T { return ONE_T{impl: v.impl.Value} }
+
+#define BINARY(_NAME_, _DOC_) \
+    __COMMENT__ _NAME_ returns _DOC_ __NEWLINE__\
+    func (v VAR_T) _NAME_(x VAR_T) VAR_T { return VAR_T{impl: v.impl._NAME_(x.impl)} }
+
+#define UNARY(_NAME_, _DOC_) \
+    __COMMENT__ _NAME_ returns _DOC_ __NEWLINE__\
+    func (v VAR_T) _NAME_() VAR_T { return VAR_T{impl: v.impl._NAME_()} }
+
+BINARY(Add, v + x)
+
+BINARY(Sub, v - x)
+
+BINARY(Mul, v * x)
+
+BINARY(Div, v / x)
+
+UNARY(Neg, -v)
+
+UNARY(Sqrt, the square root of v)
+
+UNARY(Exp, e**v)
+
+UNARY(Log, the natural logarithm of v)
+
+UNARY(Sin, the sine of v)
+
+UNARY(Cos, the cosine of v)
+
+UNARY(Tanh, the hyperbolic tangent of v)
+
+UNARY(Atan, the arc tangent of v)
+
+#undef BINARY
+#undef UNARY
+#undef DUAL_T
+#undef TAPE_T
+#undef VAR_T
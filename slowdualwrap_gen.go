@@ -0,0 +1,367 @@
+package goposit
+
+// Posit16Dual is a dual number of Posit16 for forward mode differentiation, see SlowDual
+type Posit16Dual struct{ impl *SlowDual }
+
+// NewPosit16Dual makes the dual number val + der*e, use der = 1 for the variable
+// which is being differentiated
+func NewPosit16Dual(val, der Posit16) Posit16Dual {
+	return Posit16Dual{impl: NewSlowDual(val.impl, der.impl)}
+}
+
+// Val returns the value
+func (z Posit16Dual) Val() Posit16 { return Posit16{impl: z.impl.Val} }
+
+// Der returns the derivative
+func (z Posit16Dual) Der() Posit16 { return Posit16{impl: z.impl.Der} }
+
+// Add returns z + x
+func (z Posit16Dual) Add(x Posit16Dual) Posit16Dual { return Posit16Dual{impl: z.impl.Add(x.impl)} }
+
+// Sub returns z - x
+func (z Posit16Dual) Sub(x Posit16Dual) Posit16Dual { return Posit16Dual{impl: z.impl.Sub(x.impl)} }
+
+// Mul returns z * x with the derivative rounded once
+func (z Posit16Dual) Mul(x Posit16Dual) Posit16Dual { return Posit16Dual{impl: z.impl.Mul(x.impl)} }
+
+// Div returns z / x with the derivative rounded once
+func (z Posit16Dual) Div(x Posit16Dual) Posit16Dual { return Posit16Dual{impl: z.impl.Div(x.impl)} }
+
+// Neg returns -z
+func (z Posit16Dual) Neg() Posit16Dual { return Posit16Dual{impl: z.impl.Neg()} }
+
+// Sqrt returns the square root of z
+func (z Posit16Dual) Sqrt() Posit16Dual { return Posit16Dual{impl: z.impl.Sqrt()} }
+
+// Exp returns e**z
+func (z Posit16Dual) Exp() Posit16Dual { return Posit16Dual{impl: z.impl.Exp()} }
+
+// Log returns the natural logarithm of z
+func (z Posit16Dual) Log() Posit16Dual { return Posit16Dual{impl: z.impl.Log()} }
+
+// Sin returns the sine of z
+func (z Posit16Dual) Sin() Posit16Dual { return Posit16Dual{impl: z.impl.Sin()} }
+
+// Cos returns the cosine of z
+func (z Posit16Dual) Cos() Posit16Dual { return Posit16Dual{impl: z.impl.Cos()} }
+
+// Tanh returns the hyperbolic tangent of z
+func (z Posit16Dual) Tanh() Posit16Dual { return Posit16Dual{impl: z.impl.Tanh()} }
+
+// Atan returns the arc tangent of z
+func (z Posit16Dual) Atan() Posit16Dual { return Posit16Dual{impl: z.impl.Atan()} }
+
+// Posit16Tape records operations on Posit16Var for reverse mode differentiation, see SlowTape
+type Posit16Tape struct{ impl *SlowTape }
+
+// Posit16Var is a value on a Posit16Tape
+type Posit16Var struct{ impl *SlowVar }
+
+// NewPosit16Tape creates an empty tape
+func NewPosit16Tape() Posit16Tape { return Posit16Tape{impl: NewSlowTape()} }
+
+// Var adds an input to the tape
+func (t Posit16Tape) Var(x Posit16) Posit16Var { return Posit16Var{impl: t.impl.Var(x.impl)} }
+
+// Len returns the number of values on the tape
+func (t Posit16Tape) Len() int { return t.impl.Len() }
+
+// Reset removes every value from the tape
+func (t Posit16Tape) Reset() { t.impl.Reset() }
+
+// Grad returns the derivative of out with respect to each of wrt
+func (t Posit16Tape) Grad(out Posit16Var, wrt ...Posit16Var) []Posit16 {
+	vars := make([]*SlowVar, len(wrt))
+	for i, w := range wrt {
+		vars[i] = w.impl
+	}
+	grad := t.impl.Grad(out.impl, vars...)
+	res := make([]Posit16, len(grad))
+	for i, g := range grad {
+		res[i] = Posit16{impl: g}
+	}
+	return res
+}
+
+// Value returns the value
+func (v Posit16Var) Value() Posit16 { return Posit16{impl: v.impl.Value} }
+
+// Add returns v + x
+func (v Posit16Var) Add(x Posit16Var) Posit16Var { return Posit16Var{impl: v.impl.Add(x.impl)} }
+
+// Sub returns v - x
+func (v Posit16Var) Sub(x Posit16Var) Posit16Var { return Posit16Var{impl: v.impl.Sub(x.impl)} }
+
+// Mul returns v * x
+func (v Posit16Var) Mul(x Posit16Var) Posit16Var { return Posit16Var{impl: v.impl.Mul(x.impl)} }
+
+// Div returns v / x
+func (v Posit16Var) Div(x Posit16Var) Posit16Var { return Posit16Var{impl: v.impl.Div(x.impl)} }
+
+// Neg returns -v
+func (v Posit16Var) Neg() Posit16Var { return Posit16Var{impl: v.impl.Neg()} }
+
+// Sqrt returns the square root of v
+func (v Posit16Var) Sqrt() Posit16Var { return Posit16Var{impl: v.impl.Sqrt()} }
+
+// Exp returns e**v
+func (v Posit16Var) Exp() Posit16Var { return Posit16Var{impl: v.impl.Exp()} }
+
+// Log returns the natural logarithm of v
+func (v Posit16Var) Log() Posit16Var { return Posit16Var{impl: v.impl.Log()} }
+
+// Sin returns the sine of v
+func (v Posit16Var) Sin() Posit16Var { return Posit16Var{impl: v.impl.Sin()} }
+
+// Cos returns the cosine of v
+func (v Posit16Var) Cos() Posit16Var { return Posit16Var{impl: v.impl.Cos()} }
+
+// Tanh returns the hyperbolic tangent of v
+func (v Posit16Var) Tanh() Posit16Var { return Posit16Var{impl: v.impl.Tanh()} }
+
+// Atan returns the arc tangent of v
+func (v Posit16Var) Atan() Posit16Var { return Posit16Var{impl: v.impl.Atan()} }
+
+// Posit32Dual is a dual number of Posit32 for forward mode differentiation, see SlowDual
+type Posit32Dual struct{ impl *SlowDual }
+
+// NewPosit32Dual makes the dual number val + der*e, use der = 1 for the variable
+// which is being differentiated
+func NewPosit32Dual(val, der Posit32) Posit32Dual {
+	return Posit32Dual{impl: NewSlowDual(val.impl, der.impl)}
+}
+
+// Val returns the value
+func (z Posit32Dual) Val() Posit32 { return Posit32{impl: z.impl.Val} }
+
+// Der returns the derivative
+func (z Posit32Dual) Der() Posit32 { return Posit32{impl: z.impl.Der} }
+
+// Add returns z + x
+func (z Posit32Dual) Add(x Posit32Dual) Posit32Dual { return Posit32Dual{impl: z.impl.Add(x.impl)} }
+
+// Sub returns z - x
+func (z Posit32Dual) Sub(x Posit32Dual) Posit32Dual { return Posit32Dual{impl: z.impl.Sub(x.impl)} }
+
+// Mul returns z * x with the derivative rounded once
+func (z Posit32Dual) Mul(x Posit32Dual) Posit32Dual { return Posit32Dual{impl: z.impl.Mul(x.impl)} }
+
+// Div returns z / x with the derivative rounded once
+func (z Posit32Dual) Div(x Posit32Dual) Posit32Dual { return Posit32Dual{impl: z.impl.Div(x.impl)} }
+
+// Neg returns -z
+func (z Posit32Dual) Neg() Posit32Dual { return Posit32Dual{impl: z.impl.Neg()} }
+
+// Sqrt returns the square root of z
+func (z Posit32Dual) Sqrt() Posit32Dual { return Posit32Dual{impl: z.impl.Sqrt()} }
+
+// Exp returns e**z
+func (z Posit32Dual) Exp() Posit32Dual { return Posit32Dual{impl: z.impl.Exp()} }
+
+// Log returns the natural logarithm of z
+func (z Posit32Dual) Log() Posit32Dual { return Posit32Dual{impl: z.impl.Log()} }
+
+// Sin returns the sine of z
+func (z Posit32Dual) Sin() Posit32Dual { return Posit32Dual{impl: z.impl.Sin()} }
+
+// Cos returns the cosine of z
+func (z Posit32Dual) Cos() Posit32Dual { return Posit32Dual{impl: z.impl.Cos()} }
+
+// Tanh returns the hyperbolic tangent of z
+func (z Posit32Dual) Tanh() Posit32Dual { return Posit32Dual{impl: z.impl.Tanh()} }
+
+// Atan returns the arc tangent of z
+func (z Posit32Dual) Atan() Posit32Dual { return Posit32Dual{impl: z.impl.Atan()} }
+
+// Posit32Tape records operations on Posit32Var for reverse mode differentiation, see SlowTape
+type Posit32Tape struct{ impl *SlowTape }
+
+// Posit32Var is a value on a Posit32Tape
+type Posit32Var struct{ impl *SlowVar }
+
+// NewPosit32Tape creates an empty tape
+func NewPosit32Tape() Posit32Tape { return Posit32Tape{impl: NewSlowTape()} }
+
+// Var adds an input to the tape
+func (t Posit32Tape) Var(x Posit32) Posit32Var { return Posit32Var{impl: t.impl.Var(x.impl)} }
+
+// Len returns the number of values on the tape
+func (t Posit32Tape) Len() int { return t.impl.Len() }
+
+// Reset removes every value from the tape
+func (t Posit32Tape) Reset() { t.impl.Reset() }
+
+// Grad returns the derivative of out with respect to each of wrt
+func (t Posit32Tape) Grad(out Posit32Var, wrt ...Posit32Var) []Posit32 {
+	vars := make([]*SlowVar, len(wrt))
+	for i, w := range wrt {
+		vars[i] = w.impl
+	}
+	grad := t.impl.Grad(out.impl, vars...)
+	res := make([]Posit32, len(grad))
+	for i, g := range grad {
+		res[i] = Posit32{impl: g}
+	}
+	return res
+}
+
+// Value returns the value
+func (v Posit32Var) Value() Posit32 { return Posit32{impl: v.impl.Value} }
+
+// Add returns v + x
+func (v Posit32Var) Add(x Posit32Var) Posit32Var { return Posit32Var{impl: v.impl.Add(x.impl)} }
+
+// Sub returns v - x
+func (v Posit32Var) Sub(x Posit32Var) Posit32Var { return Posit32Var{impl: v.impl.Sub(x.impl)} }
+
+// Mul returns v * x
+func (v Posit32Var) Mul(x Posit32Var) Posit32Var { return Posit32Var{impl: v.impl.Mul(x.impl)} }
+
+// Div returns v / x
+func (v Posit32Var) Div(x Posit32Var) Posit32Var { return Posit32Var{impl: v.impl.Div(x.impl)} }
+
+// Neg returns -v
+func (v Posit32Var) Neg() Posit32Var { return Posit32Var{impl: v.impl.Neg()} }
+
+// Sqrt returns the square root of v
+func (v Posit32Var) Sqrt() Posit32Var { return Posit32Var{impl: v.impl.Sqrt()} }
+
+// Exp returns e**v
+func (v Posit32Var) Exp() Posit32Var { return Posit32Var{impl: v.impl.Exp()} }
+
+// Log returns the natural logarithm of v
+func (v Posit32Var) Log() Posit32Var { return Posit32Var{impl: v.impl.Log()} }
+
+// Sin returns the sine of v
+func (v Posit32Var) Sin() Posit32Var { return Posit32Var{impl: v.impl.Sin()} }
+
+// Cos returns the cosine of v
+func (v Posit32Var) Cos() Posit32Var { return Posit32Var{impl: v.impl.Cos()} }
+
+// Tanh returns the hyperbolic tangent of v
+func (v Posit32Var) Tanh() Posit32Var { return Posit32Var{impl: v.impl.Tanh()} }
+
+// Atan returns the arc tangent of v
+func (v Posit32Var) Atan() Posit32Var { return Posit32Var{impl: v.impl.Atan()} }
+
+// Posit64Dual is a dual number of Posit64 for forward mode differentiation, see SlowDual
+type Posit64Dual struct{ impl *SlowDual }
+
+// NewPosit64Dual makes the dual number val + der*e, use der = 1 for the variable
+// which is being differentiated
+func NewPosit64Dual(val, der Posit64) Posit64Dual {
+	return Posit64Dual{impl: NewSlowDual(val.impl, der.impl)}
+}
+
+// Val returns the value
+func (z Posit64Dual) Val() Posit64 { return Posit64{impl: z.impl.Val} }
+
+// Der returns the derivative
+func (z Posit64Dual) Der() Posit64 { return Posit64{impl: z.impl.Der} }
+
+// Add returns z + x
+func (z Posit64Dual) Add(x Posit64Dual) Posit64Dual { return Posit64Dual{impl: z.impl.Add(x.impl)} }
+
+// Sub returns z - x
+func (z Posit64Dual) Sub(x Posit64Dual) Posit64Dual { return Posit64Dual{impl: z.impl.Sub(x.impl)} }
+
+// Mul returns z * x with the derivative rounded once
+func (z Posit64Dual) Mul(x Posit64Dual) Posit64Dual { return Posit64Dual{impl: z.impl.Mul(x.impl)} }
+
+// Div returns z / x with the derivative rounded once
+func (z Posit64Dual) Div(x Posit64Dual) Posit64Dual { return Posit64Dual{impl: z.impl.Div(x.impl)} }
+
+// Neg returns -z
+func (z Posit64Dual) Neg() Posit64Dual { return Posit64Dual{impl: z.impl.Neg()} }
+
+// Sqrt returns the square root of z
+func (z Posit64Dual) Sqrt() Posit64Dual { return Posit64Dual{impl: z.impl.Sqrt()} }
+
+// Exp returns e**z
+func (z Posit64Dual) Exp() Posit64Dual { return Posit64Dual{impl: z.impl.Exp()} }
+
+// Log returns the natural logarithm of z
+func (z Posit64Dual) Log() Posit64Dual { return Posit64Dual{impl: z.impl.Log()} }
+
+// Sin returns the sine of z
+func (z Posit64Dual) Sin() Posit64Dual { return Posit64Dual{impl: z.impl.Sin()} }
+
+// Cos returns the cosine of z
+func (z Posit64Dual) Cos() Posit64Dual { return Posit64Dual{impl: z.impl.Cos()} }
+
+// Tanh returns the hyperbolic tangent of z
+func (z Posit64Dual) Tanh() Posit64Dual { return Posit64Dual{impl: z.impl.Tanh()} }
+
+// Atan returns the arc tangent of z
+func (z Posit64Dual) Atan() Posit64Dual { return Posit64Dual{impl: z.impl.Atan()} }
+
+// Posit64Tape records operations on Posit64Var for reverse mode differentiation, see SlowTape
+type Posit64Tape struct{ impl *SlowTape }
+
+// Posit64Var is a value on a Posit64Tape
+type Posit64Var struct{ impl *SlowVar }
+
+// NewPosit64Tape creates an empty tape
+func NewPosit64Tape() Posit64Tape { return Posit64Tape{impl: NewSlowTape()} }
+
+// Var adds an input to the tape
+func (t Posit64Tape) Var(x Posit64) Posit64Var { return Posit64Var{impl: t.impl.Var(x.impl)} }
+
+// Len returns the number of values on the tape
+func (t Posit64Tape) Len() int { return t.impl.Len() }
+
+// Reset removes every value from the tape
+func (t Posit64Tape) Reset() { t.impl.Reset() }
+
+// Grad returns the derivative of out with respect to each of wrt
+func (t Posit64Tape) Grad(out Posit64Var, wrt ...Posit64Var) []Posit64 {
+	vars := make([]*SlowVar, len(wrt))
+	for i, w := range wrt {
+		vars[i] = w.impl
+	}
+	grad := t.impl.Grad(out.impl, vars...)
+	res := make([]Posit64, len(grad))
+	for i, g := range grad {
+		res[i] = Posit64{impl: g}
+	}
+	return res
+}
+
+// Value returns the value
+func (v Posit64Var) Value() Posit64 { return Posit64{impl: v.impl.Value} }
+
+// Add returns v + x
+func (v Posit64Var) Add(x Posit64Var) Posit64Var { return Posit64Var{impl: v.impl.Add(x.impl)} }
+
+// Sub returns v - x
+func (v Posit64Var) Sub(x Posit64Var) Posit64Var { return Posit64Var{impl: v.impl.Sub(x.impl)} }
+
+// Mul returns v * x
+func (v Posit64Var) Mul(x Posit64Var) Posit64Var { return Posit64Var{impl: v.impl.Mul(x.impl)} }
+
+// Div returns v / x
+func (v Posit64Var) Div(x Posit64Var) Posit64Var { return Posit64Var{impl: v.impl.Div(x.impl)} }
+
+// Neg returns -v
+func (v Posit64Var) Neg() Posit64Var { return Posit64Var{impl: v.impl.Neg()} }
+
+// Sqrt returns the square root of v
+func (v Posit64Var) Sqrt() Posit64Var { return Posit64Var{impl: v.impl.Sqrt()} }
+
+// Exp returns e**v
+func (v Posit64Var) Exp() Posit64Var { return Posit64Var{impl: v.impl.Exp()} }
+
+// Log returns the natural logarithm of v
+func (v Posit64Var) Log() Posit64Var { return Posit64Var{impl: v.impl.Log()} }
+
+// Sin returns the sine of v
+func (v Posit64Var) Sin() Posit64Var { return Posit64Var{impl: v.impl.Sin()} }
+
+// Cos returns the cosine of v
+func (v Posit64Var) Cos() Posit64Var { return Posit64Var{impl: v.impl.Cos()} }
+
+// Tanh returns the hyperbolic tangent of v
+func (v Posit64Var) Tanh() Posit64Var { return Posit64Var{impl: v.impl.Tanh()} }
+
+// Atan returns the arc tangent of v
+func (v Posit64Var) Atan() Posit64Var { return Posit64Var{impl: v.impl.Atan()} }
@@ -0,0 +1,46 @@
+
+__COMMENT__ IV_T is a closed interval of ONE_T, see SlowInterval
+type IV_T struct{ impl *SlowInterval }
+
+__COMMENT__ GLUE(New, IV_T) makes the interval [lo, hi], if lo is greater than hi it will panic
+func GLUE(New, IV_T)(lo, hi ONE_T) IV_T {
+    return IV_T{impl: NewSlowInterval(lo.impl, hi.impl)}
+}
+
+__COMMENT__ Lo returns the lower bound, NaR means there is no lower bound
+func (v IV_T) Lo() ONE_T { return ONE_T{impl: v.impl.Lo} }
+
+__COMMENT__ Hi returns the upper bound, NaR means there is no upper bound
+func (v IV_T) Hi() ONE_T { return ONE_T{impl: v.impl.Hi} }
+
+__COMMENT__ Add returns an interval enclosing every sum of a number in v and a number in x
+func (v IV_T) Add(x IV_T) IV_T { return IV_T{impl: v.impl.Add(x.impl)} }
+
+__COMMENT__ Sub returns an interval enclosing every difference of a number in v and a number in x
+func (v IV_T) Sub(x IV_T) IV_T { return IV_T{impl: v.impl.Sub(x.impl)} }
+
+__COMMENT__ Mul returns an interval enclosing every product of a number in v and a number in x
+func (v IV_T) Mul(x IV_T) IV_T { return IV_T{impl: v.impl.Mul(x.impl)} }
+
+__COMMENT__ Div returns an interval enclosing every quotent of a number in v and a number in x,
+__COMMENT__ if x contains zero or is itself unbounded then the result is unbounded.
+func (v IV_T) Div(x IV_T) IV_T { return IV_T{impl: v.impl.Div(x.impl)} }
+
+__COMMENT__ Sqrt returns an interval enclosing the square roots of every non-negative number in v
+func (v IV_T) Sqrt() IV_T { return IV_T{impl: v.impl.Sqrt()} }
+
+__COMMENT__ Contains returns true if x is within the interval, NaR is never contained
+func (v IV_T) Contains(x ONE_T) bool { return v.impl.Contains(x.impl) }
+
+__COMMENT__ Width returns hi - lo rounded toward positive infinity, NaR if unbounded
+func (v IV_T) Width() ONE_T { return ONE_T{impl: v.impl.Width()} }
+
+__COMMENT__ Intersect returns the intersection of two intervals, if they do not overlap then the
+__COMMENT__ second result is false.
+func (v IV_T) Intersect(x IV_T) (IV_T, bool) {
+    out, ok := v.impl.Intersect(x.impl)
+    return IV_T{impl: out}, ok
+}
+
+__COMMENT__ Hull returns the smallest interval which contains both intervals
+func (v IV_T) Hull(x IV_T) IV_T { return IV_T{impl: v.impl.Hull(x.impl)} }
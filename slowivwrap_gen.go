@@ -0,0 +1,225 @@
+package goposit
+
+// Posit8Interval is a closed interval of Posit8, see SlowInterval
+type Posit8Interval struct{ impl *SlowInterval }
+
+// NewPosit8Interval makes the interval [lo, hi], if lo is greater than hi it will panic
+func NewPosit8Interval(lo, hi Posit8) Posit8Interval {
+	return Posit8Interval{impl: NewSlowInterval(lo.impl, hi.impl)}
+}
+
+// Lo returns the lower bound, NaR means there is no lower bound
+func (v Posit8Interval) Lo() Posit8 { return Posit8{impl: v.impl.Lo} }
+
+// Hi returns the upper bound, NaR means there is no upper bound
+func (v Posit8Interval) Hi() Posit8 { return Posit8{impl: v.impl.Hi} }
+
+// Add returns an interval enclosing every sum of a number in v and a number in x
+func (v Posit8Interval) Add(x Posit8Interval) Posit8Interval {
+	return Posit8Interval{impl: v.impl.Add(x.impl)}
+}
+
+// Sub returns an interval enclosing every difference of a number in v and a number in x
+func (v Posit8Interval) Sub(x Posit8Interval) Posit8Interval {
+	return Posit8Interval{impl: v.impl.Sub(x.impl)}
+}
+
+// Mul returns an interval enclosing every product of a number in v and a number in x
+func (v Posit8Interval) Mul(x Posit8Interval) Posit8Interval {
+	return Posit8Interval{impl: v.impl.Mul(x.impl)}
+}
+
+// Div returns an interval enclosing every quotent of a number in v and a number in x,
+// if x contains zero or is itself unbounded then the result is unbounded.
+func (v Posit8Interval) Div(x Posit8Interval) Posit8Interval {
+	return Posit8Interval{impl: v.impl.Div(x.impl)}
+}
+
+// Sqrt returns an interval enclosing the square roots of every non-negative number in v
+func (v Posit8Interval) Sqrt() Posit8Interval { return Posit8Interval{impl: v.impl.Sqrt()} }
+
+// Contains returns true if x is within the interval, NaR is never contained
+func (v Posit8Interval) Contains(x Posit8) bool { return v.impl.Contains(x.impl) }
+
+// Width returns hi - lo rounded toward positive infinity, NaR if unbounded
+func (v Posit8Interval) Width() Posit8 { return Posit8{impl: v.impl.Width()} }
+
+// Intersect returns the intersection of two intervals, if they do not overlap then the
+// second result is false.
+func (v Posit8Interval) Intersect(x Posit8Interval) (Posit8Interval, bool) {
+	out, ok := v.impl.Intersect(x.impl)
+	return Posit8Interval{impl: out}, ok
+}
+
+// Hull returns the smallest interval which contains both intervals
+func (v Posit8Interval) Hull(x Posit8Interval) Posit8Interval {
+	return Posit8Interval{impl: v.impl.Hull(x.impl)}
+}
+
+// Posit16Interval is a closed interval of Posit16, see SlowInterval
+type Posit16Interval struct{ impl *SlowInterval }
+
+// NewPosit16Interval makes the interval [lo, hi], if lo is greater than hi it will panic
+func NewPosit16Interval(lo, hi Posit16) Posit16Interval {
+	return Posit16Interval{impl: NewSlowInterval(lo.impl, hi.impl)}
+}
+
+// Lo returns the lower bound, NaR means there is no lower bound
+func (v Posit16Interval) Lo() Posit16 { return Posit16{impl: v.impl.Lo} }
+
+// Hi returns the upper bound, NaR means there is no upper bound
+func (v Posit16Interval) Hi() Posit16 { return Posit16{impl: v.impl.Hi} }
+
+// Add returns an interval enclosing every sum of a number in v and a number in x
+func (v Posit16Interval) Add(x Posit16Interval) Posit16Interval {
+	return Posit16Interval{impl: v.impl.Add(x.impl)}
+}
+
+// Sub returns an interval enclosing every difference of a number in v and a number in x
+func (v Posit16Interval) Sub(x Posit16Interval) Posit16Interval {
+	return Posit16Interval{impl: v.impl.Sub(x.impl)}
+}
+
+// Mul returns an interval enclosing every product of a number in v and a number in x
+func (v Posit16Interval) Mul(x Posit16Interval) Posit16Interval {
+	return Posit16Interval{impl: v.impl.Mul(x.impl)}
+}
+
+// Div returns an interval enclosing every quotent of a number in v and a number in x,
+// if x contains zero or is itself unbounded then the result is unbounded.
+func (v Posit16Interval) Div(x Posit16Interval) Posit16Interval {
+	return Posit16Interval{impl: v.impl.Div(x.impl)}
+}
+
+// Sqrt returns an interval enclosing the square roots of every non-negative number in v
+func (v Posit16Interval) Sqrt() Posit16Interval { return Posit16Interval{impl: v.impl.Sqrt()} }
+
+// Contains returns true if x is within the interval, NaR is never contained
+func (v Posit16Interval) Contains(x Posit16) bool { return v.impl.Contains(x.impl) }
+
+// Width returns hi - lo rounded toward positive infinity, NaR if unbounded
+func (v Posit16Interval) Width() Posit16 { return Posit16{impl: v.impl.Width()} }
+
+// Intersect returns the intersection of two intervals, if they do not overlap then the
+// second result is false.
+func (v Posit16Interval) Intersect(x Posit16Interval) (Posit16Interval, bool) {
+	out, ok := v.impl.Intersect(x.impl)
+	return Posit16Interval{impl: out}, ok
+}
+
+// Hull returns the smallest interval which contains both intervals
+func (v Posit16Interval) Hull(x Posit16Interval) Posit16Interval {
+	return Posit16Interval{impl: v.impl.Hull(x.impl)}
+}
+
+// Posit32Interval is a closed interval of Posit32, see SlowInterval
+type Posit32Interval struct{ impl *SlowInterval }
+
+// NewPosit32Interval makes the interval [lo, hi], if lo is greater than hi it will panic
+func NewPosit32Interval(lo, hi Posit32) Posit32Interval {
+	return Posit32Interval{impl: NewSlowInterval(lo.impl, hi.impl)}
+}
+
+// Lo returns the lower bound, NaR means there is no lower bound
+func (v Posit32Interval) Lo() Posit32 { return Posit32{impl: v.impl.Lo} }
+
+// Hi returns the upper bound, NaR means there is no upper bound
+func (v Posit32Interval) Hi() Posit32 { return Posit32{impl: v.impl.Hi} }
+
+// Add returns an interval enclosing every sum of a number in v and a number in x
+func (v Posit32Interval) Add(x Posit32Interval) Posit32Interval {
+	return Posit32Interval{impl: v.impl.Add(x.impl)}
+}
+
+// Sub returns an interval enclosing every difference of a number in v and a number in x
+func (v Posit32Interval) Sub(x Posit32Interval) Posit32Interval {
+	return Posit32Interval{impl: v.impl.Sub(x.impl)}
+}
+
+// Mul returns an interval enclosing every product of a number in v and a number in x
+func (v Posit32Interval) Mul(x Posit32Interval) Posit32Interval {
+	return Posit32Interval{impl: v.impl.Mul(x.impl)}
+}
+
+// Div returns an interval enclosing every quotent of a number in v and a number in x,
+// if x contains zero or is itself unbounded then the result is unbounded.
+func (v Posit32Interval) Div(x Posit32Interval) Posit32Interval {
+	return Posit32Interval{impl: v.impl.Div(x.impl)}
+}
+
+// Sqrt returns an interval enclosing the square roots of every non-negative number in v
+func (v Posit32Interval) Sqrt() Posit32Interval { return Posit32Interval{impl: v.impl.Sqrt()} }
+
+// Contains returns true if x is within the interval, NaR is never contained
+func (v Posit32Interval) Contains(x Posit32) bool { return v.impl.Contains(x.impl) }
+
+// Width returns hi - lo rounded toward positive infinity, NaR if unbounded
+func (v Posit32Interval) Width() Posit32 { return Posit32{impl: v.impl.Width()} }
+
+// Intersect returns the intersection of two intervals, if they do not overlap then the
+// second result is false.
+func (v Posit32Interval) Intersect(x Posit32Interval) (Posit32Interval, bool) {
+	out, ok := v.impl.Intersect(x.impl)
+	return Posit32Interval{impl: out}, ok
+}
+
+// Hull returns the smallest interval which contains both intervals
+func (v Posit32Interval) Hull(x Posit32Interval) Posit32Interval {
+	return Posit32Interval{impl: v.impl.Hull(x.impl)}
+}
+
+// Posit64Interval is a closed interval of Posit64, see SlowInterval
+type Posit64Interval struct{ impl *SlowInterval }
+
+// NewPosit64Interval makes the interval [lo, hi], if lo is greater than hi it will panic
+func NewPosit64Interval(lo, hi Posit64) Posit64Interval {
+	return Posit64Interval{impl: NewSlowInterval(lo.impl, hi.impl)}
+}
+
+// Lo returns the lower bound, NaR means there is no lower bound
+func (v Posit64Interval) Lo() Posit64 { return Posit64{impl: v.impl.Lo} }
+
+// Hi returns the upper bound, NaR means there is no upper bound
+func (v Posit64Interval) Hi() Posit64 { return Posit64{impl: v.impl.Hi} }
+
+// Add returns an interval enclosing every sum of a number in v and a number in x
+func (v Posit64Interval) Add(x Posit64Interval) Posit64Interval {
+	return Posit64Interval{impl: v.impl.Add(x.impl)}
+}
+
+// Sub returns an interval enclosing every difference of a number in v and a number in x
+func (v Posit64Interval) Sub(x Posit64Interval) Posit64Interval {
+	return Posit64Interval{impl: v.impl.Sub(x.impl)}
+}
+
+// Mul returns an interval enclosing every product of a number in v and a number in x
+func (v Posit64Interval) Mul(x Posit64Interval) Posit64Interval {
+	return Posit64Interval{impl: v.impl.Mul(x.impl)}
+}
+
+// Div returns an interval enclosing every quotent of a number in v and a number in x,
+// if x contains zero or is itself unbounded then the result is unbounded.
+func (v Posit64Interval) Div(x Posit64Interval) Posit64Interval {
+	return Posit64Interval{impl: v.impl.Div(x.impl)}
+}
+
+// Sqrt returns an interval enclosing the square roots of every non-negative number in v
+func (v Posit64Interval) Sqrt() Posit64Interval { return Posit64Interval{impl: v.impl.Sqrt()} }
+
+// Contains returns true if x is within the interval, NaR is never contained
+func (v Posit64Interval) Contains(x Posit64) bool { return v.impl.Contains(x.impl) }
+
+// Width returns hi - lo rounded toward positive infinity, NaR if unbounded
+func (v Posit64Interval) Width() Posit64 { return Posit64{impl: v.impl.Width()} }
+
+// Intersect returns the intersection of two intervals, if they do not overlap then the
+// second result is false.
+func (v Posit64Interval) Intersect(x Posit64Interval) (Posit64Interval, bool) {
+	out, ok := v.impl.Intersect(x.impl)
+	return Posit64Interval{impl: out}, ok
+}
+
+// Hull returns the smallest interval which contains both intervals
+func (v Posit64Interval) Hull(x Posit64Interval) Posit64Interval {
+	return Posit64Interval{impl: v.impl.Hull(x.impl)}
+}
@@ -0,0 +1,93 @@
+
+__COMMENT__ MAT_T is a dense matrix of ONE_T
+type MAT_T struct{ impl *SlowMatrix }
+
+__COMMENT__ GLUE(New, MAT_T) makes a new rows by cols matrix of ONE_T, the initial value is zero
+func GLUE(New, MAT_T)(rows, cols int) MAT_T {
+    return MAT_T{impl: NewSlowMatrix(rows, cols, NBITS, ES)}
+}
+
+__COMMENT__ GLUE(slowSlice, NBITS) unwraps a slice of ONE_T
+func GLUE(slowSlice, NBITS)(x []ONE_T) []*SlowPosit {
+    out := make([]*SlowPosit, len(x))
+    for i, p := range x { out[i] = p.impl }
+    return out
+}
+
+__COMMENT__ GLUE(fromSlowSlice, NBITS) wraps a slice of SlowPosit as ONE_T
+func GLUE(fromSlowSlice, NBITS)(x []*SlowPosit) []ONE_T {
+    out := make([]ONE_T, len(x))
+    for i, p := range x { out[i] = ONE_T{impl: p} }
+    return out
+}
+
+__COMMENT__ Rows returns the number of rows in the matrix
+func (m MAT_T) Rows() int { return m.impl.Rows() }
+
+__COMMENT__ Cols returns the number of columns in the matrix
+func (m MAT_T) Cols() int { return m.impl.Cols() }
+
+__COMMENT__ At returns the element at row i column j
+func (m MAT_T) At(i, j int) ONE_T { return ONE_T{impl: m.impl.At(i, j)} }
+
+__COMMENT__ Set updates the element at row i column j
+func (m MAT_T) Set(i, j int, x ONE_T) { m.impl.Set(i, j, x.impl) }
+
+__COMMENT__ Clone makes a copy of the matrix
+func (m MAT_T) Clone() MAT_T { return MAT_T{impl: m.impl.Clone()} }
+
+__COMMENT__ T returns the transpose of the matrix
+func (m MAT_T) T() MAT_T { return MAT_T{impl: m.impl.T()} }
+
+__COMMENT__ Mul provides a thin wrapper around SlowMatrix.Mul
+func (m MAT_T) Mul(x MAT_T) MAT_T { return MAT_T{impl: m.impl.Mul(x.impl)} }
+
+__COMMENT__ MulVec provides a thin wrapper around SlowMatrix.MulVec
+func (m MAT_T) MulVec(x []ONE_T) []ONE_T {
+    return GLUE(fromSlowSlice, NBITS)(m.impl.MulVec(GLUE(slowSlice, NBITS)(x)))
+}
+
+__COMMENT__ SolveLU solves m*x = b using LU factorization with partial pivoting
+func (m MAT_T) SolveLU(b []ONE_T) ([]ONE_T, error) {
+    f, err := m.impl.LU()
+    if err != nil {
+        return nil, err
+    }
+    return GLUE(fromSlowSlice, NBITS)(f.Solve(GLUE(slowSlice, NBITS)(b))), nil
+}
+
+__COMMENT__ SolveCholesky solves m*x = b for a symmetric positive definite m
+func (m MAT_T) SolveCholesky(b []ONE_T) ([]ONE_T, error) {
+    f, err := m.impl.Cholesky()
+    if err != nil {
+        return nil, err
+    }
+    return GLUE(fromSlowSlice, NBITS)(f.Solve(GLUE(slowSlice, NBITS)(b))), nil
+}
+
+__COMMENT__ SolveQR finds the least squares solution of m*x = b using Householder QR
+func (m MAT_T) SolveQR(b []ONE_T) ([]ONE_T, error) {
+    f, err := m.impl.QR()
+    if err != nil {
+        return nil, err
+    }
+    return GLUE(fromSlowSlice, NBITS)(f.Solve(GLUE(slowSlice, NBITS)(b))), nil
+}
+
+__COMMENT__ Residual computes b - m*x exactly multiplied and compensated in a BIGGER_T
+func (m MAT_T) Residual(b, x []ONE_T) []BIGGER_T {
+    r := m.impl.Residual(GLUE(slowSlice, NBITS)(b), GLUE(slowSlice, NBITS)(x))
+    out := make([]BIGGER_T, len(r))
+    for i, p := range r { out[i] = BIGGER_T{impl: p} }
+    return out
+}
+
+__COMMENT__ RefineSolve factors m in ONE_T and refines the solution using residuals
+__COMMENT__ computed in BIGGER_T, see SlowMatrix.RefineSolve
+func (m MAT_T) RefineSolve(b []ONE_T, iters int) ([]ONE_T, error) {
+    x, err := m.impl.RefineSolve(GLUE(slowSlice, NBITS)(b), iters)
+    if err != nil {
+        return nil, err
+    }
+    return GLUE(fromSlowSlice, NBITS)(x), nil
+}
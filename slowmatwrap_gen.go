@@ -0,0 +1,203 @@
+package goposit
+
+// Posit16Matrix is a dense matrix of Posit16
+type Posit16Matrix struct{ impl *SlowMatrix }
+
+// NewPosit16Matrix makes a new rows by cols matrix of Posit16, the initial value is zero
+func NewPosit16Matrix(rows, cols int) Posit16Matrix {
+	return Posit16Matrix{impl: NewSlowMatrix(rows, cols, 16, 1)}
+}
+
+// slowSlice16 unwraps a slice of Posit16
+func slowSlice16(x []Posit16) []*SlowPosit {
+	out := make([]*SlowPosit, len(x))
+	for i, p := range x {
+		out[i] = p.impl
+	}
+	return out
+}
+
+// fromSlowSlice16 wraps a slice of SlowPosit as Posit16
+func fromSlowSlice16(x []*SlowPosit) []Posit16 {
+	out := make([]Posit16, len(x))
+	for i, p := range x {
+		out[i] = Posit16{impl: p}
+	}
+	return out
+}
+
+// Rows returns the number of rows in the matrix
+func (m Posit16Matrix) Rows() int { return m.impl.Rows() }
+
+// Cols returns the number of columns in the matrix
+func (m Posit16Matrix) Cols() int { return m.impl.Cols() }
+
+// At returns the element at row i column j
+func (m Posit16Matrix) At(i, j int) Posit16 { return Posit16{impl: m.impl.At(i, j)} }
+
+// Set updates the element at row i column j
+func (m Posit16Matrix) Set(i, j int, x Posit16) { m.impl.Set(i, j, x.impl) }
+
+// Clone makes a copy of the matrix
+func (m Posit16Matrix) Clone() Posit16Matrix { return Posit16Matrix{impl: m.impl.Clone()} }
+
+// T returns the transpose of the matrix
+func (m Posit16Matrix) T() Posit16Matrix { return Posit16Matrix{impl: m.impl.T()} }
+
+// Mul provides a thin wrapper around SlowMatrix.Mul
+func (m Posit16Matrix) Mul(x Posit16Matrix) Posit16Matrix {
+	return Posit16Matrix{impl: m.impl.Mul(x.impl)}
+}
+
+// MulVec provides a thin wrapper around SlowMatrix.MulVec
+func (m Posit16Matrix) MulVec(x []Posit16) []Posit16 {
+	return fromSlowSlice16(m.impl.MulVec(slowSlice16(x)))
+}
+
+// SolveLU solves m*x = b using LU factorization with partial pivoting
+func (m Posit16Matrix) SolveLU(b []Posit16) ([]Posit16, error) {
+	f, err := m.impl.LU()
+	if err != nil {
+		return nil, err
+	}
+	return fromSlowSlice16(f.Solve(slowSlice16(b))), nil
+}
+
+// SolveCholesky solves m*x = b for a symmetric positive definite m
+func (m Posit16Matrix) SolveCholesky(b []Posit16) ([]Posit16, error) {
+	f, err := m.impl.Cholesky()
+	if err != nil {
+		return nil, err
+	}
+	return fromSlowSlice16(f.Solve(slowSlice16(b))), nil
+}
+
+// SolveQR finds the least squares solution of m*x = b using Householder QR
+func (m Posit16Matrix) SolveQR(b []Posit16) ([]Posit16, error) {
+	f, err := m.impl.QR()
+	if err != nil {
+		return nil, err
+	}
+	return fromSlowSlice16(f.Solve(slowSlice16(b))), nil
+}
+
+// Residual computes b - m*x exactly multiplied and compensated in a Posit32
+func (m Posit16Matrix) Residual(b, x []Posit16) []Posit32 {
+	r := m.impl.Residual(slowSlice16(b), slowSlice16(x))
+	out := make([]Posit32, len(r))
+	for i, p := range r {
+		out[i] = Posit32{impl: p}
+	}
+	return out
+}
+
+// RefineSolve factors m in Posit16 and refines the solution using residuals
+// computed in Posit32, see SlowMatrix.RefineSolve
+func (m Posit16Matrix) RefineSolve(b []Posit16, iters int) ([]Posit16, error) {
+	x, err := m.impl.RefineSolve(slowSlice16(b), iters)
+	if err != nil {
+		return nil, err
+	}
+	return fromSlowSlice16(x), nil
+}
+
+// Posit32Matrix is a dense matrix of Posit32
+type Posit32Matrix struct{ impl *SlowMatrix }
+
+// NewPosit32Matrix makes a new rows by cols matrix of Posit32, the initial value is zero
+func NewPosit32Matrix(rows, cols int) Posit32Matrix {
+	return Posit32Matrix{impl: NewSlowMatrix(rows, cols, 32, 2)}
+}
+
+// slowSlice32 unwraps a slice of Posit32
+func slowSlice32(x []Posit32) []*SlowPosit {
+	out := make([]*SlowPosit, len(x))
+	for i, p := range x {
+		out[i] = p.impl
+	}
+	return out
+}
+
+// fromSlowSlice32 wraps a slice of SlowPosit as Posit32
+func fromSlowSlice32(x []*SlowPosit) []Posit32 {
+	out := make([]Posit32, len(x))
+	for i, p := range x {
+		out[i] = Posit32{impl: p}
+	}
+	return out
+}
+
+// Rows returns the number of rows in the matrix
+func (m Posit32Matrix) Rows() int { return m.impl.Rows() }
+
+// Cols returns the number of columns in the matrix
+func (m Posit32Matrix) Cols() int { return m.impl.Cols() }
+
+// At returns the element at row i column j
+func (m Posit32Matrix) At(i, j int) Posit32 { return Posit32{impl: m.impl.At(i, j)} }
+
+// Set updates the element at row i column j
+func (m Posit32Matrix) Set(i, j int, x Posit32) { m.impl.Set(i, j, x.impl) }
+
+// Clone makes a copy of the matrix
+func (m Posit32Matrix) Clone() Posit32Matrix { return Posit32Matrix{impl: m.impl.Clone()} }
+
+// T returns the transpose of the matrix
+func (m Posit32Matrix) T() Posit32Matrix { return Posit32Matrix{impl: m.impl.T()} }
+
+// Mul provides a thin wrapper around SlowMatrix.Mul
+func (m Posit32Matrix) Mul(x Posit32Matrix) Posit32Matrix {
+	return Posit32Matrix{impl: m.impl.Mul(x.impl)}
+}
+
+// MulVec provides a thin wrapper around SlowMatrix.MulVec
+func (m Posit32Matrix) MulVec(x []Posit32) []Posit32 {
+	return fromSlowSlice32(m.impl.MulVec(slowSlice32(x)))
+}
+
+// SolveLU solves m*x = b using LU factorization with partial pivoting
+func (m Posit32Matrix) SolveLU(b []Posit32) ([]Posit32, error) {
+	f, err := m.impl.LU()
+	if err != nil {
+		return nil, err
+	}
+	return fromSlowSlice32(f.Solve(slowSlice32(b))), nil
+}
+
+// SolveCholesky solves m*x = b for a symmetric positive definite m
+func (m Posit32Matrix) SolveCholesky(b []Posit32) ([]Posit32, error) {
+	f, err := m.impl.Cholesky()
+	if err != nil {
+		return nil, err
+	}
+	return fromSlowSlice32(f.Solve(slowSlice32(b))), nil
+}
+
+// SolveQR finds the least squares solution of m*x = b using Householder QR
+func (m Posit32Matrix) SolveQR(b []Posit32) ([]Posit32, error) {
+	f, err := m.impl.QR()
+	if err != nil {
+		return nil, err
+	}
+	return fromSlowSlice32(f.Solve(slowSlice32(b))), nil
+}
+
+// Residual computes b - m*x exactly multiplied and compensated in a Posit64
+func (m Posit32Matrix) Residual(b, x []Posit32) []Posit64 {
+	r := m.impl.Residual(slowSlice32(b), slowSlice32(x))
+	out := make([]Posit64, len(r))
+	for i, p := range r {
+		out[i] = Posit64{impl: p}
+	}
+	return out
+}
+
+// RefineSolve factors m in Posit32 and refines the solution using residuals
+// computed in Posit64, see SlowMatrix.RefineSolve
+func (m Posit32Matrix) RefineSolve(b []Posit32, iters int) ([]Posit32, error) {
+	x, err := m.impl.RefineSolve(slowSlice32(b), iters)
+	if err != nil {
+		return nil, err
+	}
+	return fromSlowSlice32(x), nil
+}
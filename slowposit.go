@@ -2,6 +2,7 @@ package goposit
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"math/bits"
 )
@@ -37,6 +38,64 @@ func checkSub(f *big.Float, x *big.Float) {
 	assertExact(f)
 }
 
+// exactPrec returns the precision needed to hold x+y without rounding
+func exactPrec(x, y *big.Float) uint {
+	if x.Sign() == 0 || x.IsInf() {
+		return y.MinPrec() + 1
+	}
+	if y.Sign() == 0 || y.IsInf() {
+		return x.MinPrec() + 1
+	}
+	xe := x.MantExp(nil)
+	ye := y.MantExp(nil)
+	top := xe
+	if ye > top {
+		top = ye
+	}
+	bottom := xe - int(x.MinPrec())
+	if b := ye - int(y.MinPrec()); b < bottom {
+		bottom = b
+	}
+	return uint(top-bottom) + 1
+}
+
+// exactAdd returns a new float which is exactly x+y
+func exactAdd(x, y *big.Float) *big.Float {
+	z := new(big.Float)
+	z.SetPrec(exactPrec(x, y))
+	z.Add(x, y)
+	assertExact(z)
+	return z
+}
+
+// roundOdd takes f which has been rounded toward zero from a true value and if it was
+// inexact it sets a bit below the last bit of f, this is rounding to odd and as long as f
+// has at least 2 more bits than the posit it makes sure the true value is rounded only once.
+func roundOdd(f *big.Float, inexact bool) *big.Float {
+	if !inexact || f.Sign() == 0 {
+		return f
+	}
+	sticky := new(big.Float).SetMantExp(bigf1, f.MantExp(nil)-int(f.Prec())-1)
+	if f.Sign() < 0 {
+		sticky.Neg(sticky)
+	}
+	return exactAdd(f, sticky)
+}
+
+// quoOdd returns x/y with prec bits rounded to odd, see roundOdd
+func quoOdd(x, y *big.Float, prec uint) *big.Float {
+	q := new(big.Float).SetPrec(prec).SetMode(big.ToZero).Quo(x, y)
+	return roundOdd(q, q.Acc() != big.Exact)
+}
+
+// sqrtOdd returns the square root of x with prec bits rounded to odd, see roundOdd
+func sqrtOdd(x *big.Float, prec uint) *big.Float {
+	s := new(big.Float).SetPrec(prec).SetMode(big.ToZero).Sqrt(x)
+	// Sqrt does not report accuracy so check by squaring, which is exact
+	sq := new(big.Float).SetPrec(2*s.Prec()).Mul(s, s)
+	return roundOdd(s, sq.Cmp(x) != 0)
+}
+
 func popcnt(i *big.Int) int {
 	cnt := 0
 	for _, w := range i.Bits() {
@@ -146,6 +205,30 @@ func (p *SlowPosit) MinNeg() *SlowPosit {
 	return p
 }
 
+// Pi sets the posit to pi, correctly rounded
+func (p *SlowPosit) Pi() *SlowPosit {
+	p.FromFloat(BigPi(p.hiPrec()), false)
+	return p
+}
+
+// E sets the posit to e, correctly rounded
+func (p *SlowPosit) E() *SlowPosit {
+	p.FromFloat(BigExp(bigf1, p.hiPrec()), false)
+	return p
+}
+
+// Ln2 sets the posit to the natural log of 2, correctly rounded
+func (p *SlowPosit) Ln2() *SlowPosit {
+	p.FromFloat(BigLn2(p.hiPrec()), false)
+	return p
+}
+
+// Sqrt2 sets the posit to the square root of 2, correctly rounded
+func (p *SlowPosit) Sqrt2() *SlowPosit {
+	p.FromFloat(newBig(p.hiPrec()).Sqrt(big.NewFloat(2)), false)
+	return p
+}
+
 func (p *SlowPosit) RawHex() string {
 	return p.Bits.Text(16)
 }
@@ -301,6 +384,11 @@ func (p *SlowPosit) FromFloat(input *big.Float, truncate bool) bool {
 	// clear the sign bit
 	out.SetBit(out, int(p.nbits-1), 0)
 
+	// a number which is not zero never rounds to zero, it becomes minpos
+	if out.Sign() == 0 && !truncate {
+		out.SetInt64(1)
+	}
+
 	if negative {
 		negate(out, p.nbits)
 	}
@@ -366,7 +454,10 @@ func (p *SlowPosit) ToFloat() *big.Float {
 		// Make sure we have enough precision
 		f1.SetPrec(uint(neededPrecision))
 	}
-	for _, w := range i1.Bits() {
+	// Bits() is least significant word first
+	words := i1.Bits()
+	for i := len(words) - 1; i >= 0; i-- {
+		w := words[i]
 		fw := big.NewFloat(0)
 		fw.SetPrec(bits.UintSize)
 		fw.SetUint64(uint64(w))
@@ -431,27 +522,28 @@ func outPosit(template *SlowPosit, f *big.Float) *SlowPosit {
 }
 
 func addExact(p *SlowPosit, x *big.Float, y *big.Float) (*SlowPosit, *SlowPosit) {
-	// We can have loss of precision during the add OR during the serialziation
-	z := new(big.Float)
-	z.SetMode(big.ToNegativeInf)
-	z.Add(x, y)
-	zp := SlowPosit{nbits: p.nbits, es: p.es}
-	zp.FromFloat(z, true)
-
-	z2 := zp.ToFloat()
-	z2.SetMode(big.ToNegativeInf)
-	if x.Cmp(y) > 0 {
-		z2.Sub(z2, x)
-		z2.Sub(z2, y)
-	} else {
-		z2.Sub(z2, y)
-		z2.Sub(z2, x)
+	if x.IsInf() || y.IsInf() {
+		return p.Clone().NaR(), p.Clone().NaR()
+	}
+
+	// The truncated sum is the largest posit which is not more than the true sum, FromFloat
+	// truncates toward zero so a negative sum may need one step down.
+	sum := exactAdd(x, y)
+	zp := &SlowPosit{nbits: p.nbits, es: p.es}
+	zp.FromFloat(sum, true)
+	if zp.ToFloat().Cmp(sum) > 0 {
+		if down := zp.NextDown(); !down.IsNaR() {
+			zp = down
+		}
 	}
+
+	// The remainder is the true sum minus the truncated sum, computed with
+	// enough precision that nothing is lost along the way.
+	zf := zp.ToFloat()
+	rem := exactAdd(sum, zf.Neg(zf))
 	remp := SlowPosit{nbits: p.nbits, es: p.es}
-	if exact := remp.FromFloat(z, false); !exact {
-		panic("inexact conversion when using addExact")
-	}
-	return &zp, &remp
+	remp.FromFloat(rem, false)
+	return zp, &remp
 }
 
 /// math ////
@@ -459,15 +551,27 @@ func addExact(p *SlowPosit, x *big.Float, y *big.Float) (*SlowPosit, *SlowPosit)
 // Add takes the sum of two posits
 // p.Add(x) creates a new posit z which is p+x, neither p nor x are altered
 func (p *SlowPosit) Add(x *SlowPosit) *SlowPosit {
+	assertCompat(p, x)
+	if t := p.table(tableAdd); t != nil {
+		return p.lookup2(t, x)
+	}
+	return p.add(x)
+}
+
+func (p *SlowPosit) add(x *SlowPosit) *SlowPosit {
+	if p.IsNaR() || x.IsNaR() {
+		return p.Clone().NaR()
+	}
 	pf, xf := getFloats(p, x)
-	xf.Add(pf, xf)
-	return outPosit(p, xf)
+	return outPosit(p, exactAdd(pf, xf))
 }
 
 // AddExact returns exactly the sum of two posits, represented as two
 // more posits, the first result is a posit which is the sum truncated
 // (round to negative infinity) and the second posit is the difference between
 // the first posit and the true sum. That is to say true_sum = out2 + out1.
+// In rare cases the difference needs more bits than the posit has, then it is
+// rounded to nearest even.
 func (p *SlowPosit) AddExact(x *SlowPosit) (*SlowPosit, *SlowPosit) {
 	pf, xf := getFloats(p, x)
 	return addExact(p, pf, xf)
@@ -476,15 +580,24 @@ func (p *SlowPosit) AddExact(x *SlowPosit) (*SlowPosit, *SlowPosit) {
 // Sub takes the difference of two posits
 // p.Sub(x) creates a new posit z which is p-x, neither p nor x are altered
 func (p *SlowPosit) Sub(x *SlowPosit) *SlowPosit {
+	assertCompat(p, x)
+	if t := p.table(tableAdd); t != nil {
+		// negation is exact so p - x is p + -x
+		return p.lookup2(t, x.neg())
+	}
+	if p.IsNaR() || x.IsNaR() {
+		return p.Clone().NaR()
+	}
 	pf, xf := getFloats(p, x)
-	xf.Sub(pf, xf)
-	return outPosit(p, xf)
+	return outPosit(p, exactAdd(pf, xf.Neg(xf)))
 }
 
 // SubExact returns exactly the difference of two posits, represented as two
 // more posits, the first result is a posit which is the difference truncated
 // (round to negative infinity) and the second posit is the difference between
 // the first posit and the true sum. That is to say true_sum = out2 + out1.
+// In rare cases the difference needs more bits than the posit has, then it is
+// rounded to nearest even.
 func (p *SlowPosit) SubExact(x *SlowPosit) (*SlowPosit, *SlowPosit) {
 	pf, xf := getFloats(p, x)
 	xf.Neg(xf)
@@ -494,9 +607,19 @@ func (p *SlowPosit) SubExact(x *SlowPosit) (*SlowPosit, *SlowPosit) {
 // Mul takes the product of two posits
 // p.Mul(x) creates a new posit z which is x*y, neither p nor x are altered
 func (p *SlowPosit) Mul(x *SlowPosit) *SlowPosit {
+	assertCompat(p, x)
+	if t := p.table(tableMul); t != nil {
+		return p.lookup2(t, x)
+	}
+	return p.mul(x)
+}
+
+func (p *SlowPosit) mul(x *SlowPosit) *SlowPosit {
+	if p.IsNaR() || x.IsNaR() {
+		return p.Clone().NaR()
+	}
 	pf, xf := getFloats(p, x)
-	xf.Mul(pf, xf)
-	return outPosit(p, xf)
+	return outPosit(p, new(big.Float).SetPrec(pf.Prec()+xf.Prec()).Mul(pf, xf))
 }
 
 // MulPromote takes the product of two posits
@@ -504,42 +627,63 @@ func (p *SlowPosit) Mul(x *SlowPosit) *SlowPosit {
 // next larger means the bit width is doubled and the exponent size is increased by 1
 // this function is guaranteed not to round
 func (p *SlowPosit) MulPromote(x *SlowPosit) *SlowPosit {
-	pf, xf := getFloats(p, x)
-	pf.SetPrec(x.nbits * 4)
-	xf.SetPrec(x.nbits * 4)
-	xf.Mul(xf, pf)
 	bigger := &SlowPosit{nbits: p.nbits * 2, es: p.es + 1}
-	return outPosit(bigger, xf)
+	if p.IsNaR() || x.IsNaR() {
+		return bigger.NaR()
+	}
+	pf, xf := getFloats(p, x)
+	return outPosit(bigger, new(big.Float).SetPrec(pf.Prec()+xf.Prec()).Mul(pf, xf))
 }
 
 // Div takes the quotent of two posits
 // p.Div(x) creates a new posit z which is p/x, neither p nor x are altered
 func (p *SlowPosit) Div(x *SlowPosit) *SlowPosit {
+	assertCompat(p, x)
+	if t := p.table(tableDiv); t != nil {
+		return p.lookup2(t, x)
+	}
+	return p.div(x)
+}
+
+func (p *SlowPosit) div(x *SlowPosit) *SlowPosit {
+	return outPosit(p, quo(p, x))
+}
+
+// quo divides two posits with enough precision to be rounded once into a posit of twice
+// the size, if either is NaR or x is zero then the result is infinite which becomes NaR.
+func quo(p, x *SlowPosit) *big.Float {
+	if p.IsNaR() || x.IsNaR() || x.Bits.Sign() == 0 {
+		return new(big.Float).SetInf(false)
+	}
 	pf, xf := getFloats(p, x)
-	xf.Quo(pf, xf)
-	return outPosit(p, xf)
+	return quoOdd(pf, xf, 4*p.nbits+2)
 }
 
 // DivPromote takes the quotent of two posits
 // p.DivPromote(x) creates a new posit z which is p/x, as the next larger posit size
 // next larger means the bit width is doubled and the exponent size is increased by 1
-// this function is guaranteed not to round
+// the quotient is rounded to nearest even in the larger size
 func (p *SlowPosit) DivPromote(x *SlowPosit) *SlowPosit {
-	pf, xf := getFloats(p, x)
-	pf.SetPrec(x.nbits * 4)
-	xf.SetPrec(x.nbits * 4)
-	xf.Quo(xf, pf)
 	bigger := &SlowPosit{nbits: p.nbits * 2, es: p.es + 1}
-	return outPosit(bigger, xf)
+	return outPosit(bigger, quo(p, x))
 }
 
 // Sqrt finds the square root of a posit
 // p.Sqrt() returns a new posit, p is not altered
 func (p *SlowPosit) Sqrt() *SlowPosit {
-	xf := p.ToFloat()
-	xf.SetPrec(p.nbits * 2)
-	xf.Sqrt(xf)
-	return outPosit(p, xf)
+	if t := p.table(tableSqrt); t != nil {
+		out := NewSlowPosit(p.nbits, p.es)
+		out.Bits.SetUint64(uint64(t[p.Bits.Uint64()]))
+		return out
+	}
+	return p.sqrt()
+}
+
+func (p *SlowPosit) sqrt() *SlowPosit {
+	if p.IsNaR() || p.Bits.Bit(p.signBitIdx()) == 1 {
+		return p.Clone().NaR()
+	}
+	return outPosit(p, sqrtOdd(p.ToFloat(), 2*p.nbits+2))
 }
 
 //// conversions ////
@@ -562,81 +706,57 @@ func (p *SlowPosit) FromUint(i uint64) *SlowPosit {
 	return outPosit(p, f)
 }
 
+// roundEven rounds a float to the nearest integer, ties to even
+func roundEven(f *big.Float) *big.Int {
+	out, _ := f.Int(nil)
+	frac := exactAdd(f, new(big.Float).SetInt(out).Neg(new(big.Float).SetInt(out)))
+	// compare 2*|frac| to 1
+	c := frac.SetMantExp(frac, 1).Abs(frac).Cmp(bigf1)
+	if c > 0 || (c == 0 && out.Bit(0) == 1) {
+		if f.Sign() < 0 {
+			out.Sub(out, bigi1)
+		} else {
+			out.Add(out, bigi1)
+		}
+	}
+	return out
+}
+
 // Int outputs an int64 representation of the value of the posit
 // lost bits are rounded to nearest even and if the number is greater than or equal
 // to two to the 63rd power, the maximum 0x7fffffffffffffff for positive input or
-// 0xffffffffffffffff for negative input will return.
+// -0x7fffffffffffffff for negative input will return, NaR also returns the maximum.
 // Likewise if the number is less than or equal to 1/2 it will round down to zero.
-func (p *SlowPosit) Int() (out int64) {
-	f := p.ToFloat()
-	if f.IsInf() {
-		return 0x7fffffffffffffff
-	}
-	if f.Sign() == 0 {
-		return 0
+func (p *SlowPosit) Int() int64 {
+	if p.IsNaR() {
+		return math.MaxInt64
 	}
-	exp := f.MantExp(nil)
-	if exp >= 64 {
-		out = 0x7fffffffffffffff
-		if f.Sign() < 0 {
-			out = -out
+	i := roundEven(p.ToFloat())
+	if i.CmpAbs(big.NewInt(math.MaxInt64)) > 0 {
+		if i.Sign() < 0 {
+			return -math.MaxInt64
 		}
-		return
+		return math.MaxInt64
 	}
-	twoToThe64 := big.NewFloat(18446744073709551616.0)
-	if f.Sign() < 0 {
-		twoToThe64 = twoToThe64.Neg(twoToThe64)
-	}
-	f.Add(f, twoToThe64)
-	if f.MantExp(nil) != 64 {
-		panic("internal: exponent calculation error")
-	}
-
-	f.SetMode(big.ToNearestEven)
-	f.SetPrec(64)
-
-	f.Sub(f, twoToThe64)
-	out, acc := f.Int64()
-	if acc != big.Exact {
-		panic("Accuracy should be Exact")
-	}
-	return
+	return i.Int64()
 }
 
 // Uint outputs a uint64 representation of the value of the posit, if the posit
 // is less than or equal to 1/2, 0 will be returned and if the value is greater
-// than two to the 64 power, 0xffffffffffffffff will be returned.
-func (p *SlowPosit) Uint() (out uint64) {
-	f := p.ToFloat()
-	if f.IsInf() {
-		return 0xffffffffffffffff
+// than two to the 64 power, 0xffffffffffffffff will be returned. Negative numbers
+// return 0 and NaR returns the maximum.
+func (p *SlowPosit) Uint() uint64 {
+	if p.IsNaR() {
+		return math.MaxUint64
 	}
-	if f.Sign() == 0 {
+	i := roundEven(p.ToFloat())
+	if i.Sign() < 0 {
 		return 0
 	}
-	exp := f.MantExp(nil)
-	if exp > 64 {
-		out = 0xffffffffffffffff
-		return
-	}
-	twoToThe65 := big.NewFloat(36893488147419103232.0)
-	if f.Sign() < 0 {
-		twoToThe65 = twoToThe65.Neg(twoToThe65)
+	if !i.IsUint64() {
+		return math.MaxUint64
 	}
-	f.Add(f, twoToThe65)
-	if f.MantExp(nil) != 65 {
-		panic("internal: exponent calculation error")
-	}
-
-	f.SetMode(big.ToNearestEven)
-	f.SetPrec(65)
-
-	f.Sub(f, twoToThe65)
-	out, acc := f.Uint64()
-	if acc != big.Exact {
-		panic("Accuracy should be Exact")
-	}
-	return
+	return i.Uint64()
 }
 
 //// binary manipulation ////
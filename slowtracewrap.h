@@ -0,0 +1,38 @@
+
+__COMMENT__ TR_T is a ONE_T with a high precision shadow, see SlowTraced
+type TR_T struct{ impl *SlowTraced }
+
+__COMMENT__ GLUE(New, TR_T) starts tracing x with t, its shadow is its exact value
+func GLUE(New, TR_T)(t *Tracer, x ONE_T) TR_T { return TR_T{impl: t.Trace(x.impl)} }
+
+__COMMENT__ GLUE(GLUE(New, TR_T), Float) rounds f to a ONE_T and starts tracing it with f as the shadow
+func GLUE(GLUE(New, TR_T), Float)(t *Tracer, f *big.Float) TR_T {
+    return TR_T{impl: t.TraceFloat(NBITS, ES, f)}
+}
+
+__COMMENT__ Value returns the posit
+func (v TR_T) Value() ONE_T { return ONE_T{impl: v.impl.Value} }
+
+__COMMENT__ Shadow returns the high precision value, nil if it is undefined
+func (v TR_T) Shadow() *big.Float { return v.impl.Shadow }
+
+__COMMENT__ Err returns the relative error of the posit compared to its shadow
+func (v TR_T) Err() float64 { return v.impl.Err() }
+
+__COMMENT__ Add returns v + x
+func (v TR_T) Add(x TR_T) TR_T { return TR_T{impl: v.impl.Add(x.impl)} }
+
+__COMMENT__ Sub returns v - x
+func (v TR_T) Sub(x TR_T) TR_T { return TR_T{impl: v.impl.Sub(x.impl)} }
+
+__COMMENT__ Mul returns v * x
+func (v TR_T) Mul(x TR_T) TR_T { return TR_T{impl: v.impl.Mul(x.impl)} }
+
+__COMMENT__ Div returns v / x
+func (v TR_T) Div(x TR_T) TR_T { return TR_T{impl: v.impl.Div(x.impl)} }
+
+__COMMENT__ Sqrt returns the square root of v
+func (v TR_T) Sqrt() TR_T { return TR_T{impl: v.impl.Sqrt()} }
+
+__COMMENT__ Neg returns -v
+func (v TR_T) Neg() TR_T { return TR_T{impl: v.impl.Neg()} }
@@ -0,0 +1,187 @@
+package goposit
+
+import (
+	"math/big"
+)
+
+// Posit8Traced is a Posit8 with a high precision shadow, see SlowTraced
+type Posit8Traced struct{ impl *SlowTraced }
+
+// NewPosit8Traced starts tracing x with t, its shadow is its exact value
+func NewPosit8Traced(t *Tracer, x Posit8) Posit8Traced { return Posit8Traced{impl: t.Trace(x.impl)} }
+
+// NewPosit8TracedFloat rounds f to a Posit8 and starts tracing it with f as the shadow
+func NewPosit8TracedFloat(t *Tracer, f *big.Float) Posit8Traced {
+	return Posit8Traced{impl: t.TraceFloat(8, 0, f)}
+}
+
+// Value returns the posit
+func (v Posit8Traced) Value() Posit8 { return Posit8{impl: v.impl.Value} }
+
+// Shadow returns the high precision value, nil if it is undefined
+func (v Posit8Traced) Shadow() *big.Float { return v.impl.Shadow }
+
+// Err returns the relative error of the posit compared to its shadow
+func (v Posit8Traced) Err() float64 { return v.impl.Err() }
+
+// Add returns v + x
+func (v Posit8Traced) Add(x Posit8Traced) Posit8Traced { return Posit8Traced{impl: v.impl.Add(x.impl)} }
+
+// Sub returns v - x
+func (v Posit8Traced) Sub(x Posit8Traced) Posit8Traced { return Posit8Traced{impl: v.impl.Sub(x.impl)} }
+
+// Mul returns v * x
+func (v Posit8Traced) Mul(x Posit8Traced) Posit8Traced { return Posit8Traced{impl: v.impl.Mul(x.impl)} }
+
+// Div returns v / x
+func (v Posit8Traced) Div(x Posit8Traced) Posit8Traced { return Posit8Traced{impl: v.impl.Div(x.impl)} }
+
+// Sqrt returns the square root of v
+func (v Posit8Traced) Sqrt() Posit8Traced { return Posit8Traced{impl: v.impl.Sqrt()} }
+
+// Neg returns -v
+func (v Posit8Traced) Neg() Posit8Traced { return Posit8Traced{impl: v.impl.Neg()} }
+
+// Posit16Traced is a Posit16 with a high precision shadow, see SlowTraced
+type Posit16Traced struct{ impl *SlowTraced }
+
+// NewPosit16Traced starts tracing x with t, its shadow is its exact value
+func NewPosit16Traced(t *Tracer, x Posit16) Posit16Traced {
+	return Posit16Traced{impl: t.Trace(x.impl)}
+}
+
+// NewPosit16TracedFloat rounds f to a Posit16 and starts tracing it with f as the shadow
+func NewPosit16TracedFloat(t *Tracer, f *big.Float) Posit16Traced {
+	return Posit16Traced{impl: t.TraceFloat(16, 1, f)}
+}
+
+// Value returns the posit
+func (v Posit16Traced) Value() Posit16 { return Posit16{impl: v.impl.Value} }
+
+// Shadow returns the high precision value, nil if it is undefined
+func (v Posit16Traced) Shadow() *big.Float { return v.impl.Shadow }
+
+// Err returns the relative error of the posit compared to its shadow
+func (v Posit16Traced) Err() float64 { return v.impl.Err() }
+
+// Add returns v + x
+func (v Posit16Traced) Add(x Posit16Traced) Posit16Traced {
+	return Posit16Traced{impl: v.impl.Add(x.impl)}
+}
+
+// Sub returns v - x
+func (v Posit16Traced) Sub(x Posit16Traced) Posit16Traced {
+	return Posit16Traced{impl: v.impl.Sub(x.impl)}
+}
+
+// Mul returns v * x
+func (v Posit16Traced) Mul(x Posit16Traced) Posit16Traced {
+	return Posit16Traced{impl: v.impl.Mul(x.impl)}
+}
+
+// Div returns v / x
+func (v Posit16Traced) Div(x Posit16Traced) Posit16Traced {
+	return Posit16Traced{impl: v.impl.Div(x.impl)}
+}
+
+// Sqrt returns the square root of v
+func (v Posit16Traced) Sqrt() Posit16Traced { return Posit16Traced{impl: v.impl.Sqrt()} }
+
+// Neg returns -v
+func (v Posit16Traced) Neg() Posit16Traced { return Posit16Traced{impl: v.impl.Neg()} }
+
+// Posit32Traced is a Posit32 with a high precision shadow, see SlowTraced
+type Posit32Traced struct{ impl *SlowTraced }
+
+// NewPosit32Traced starts tracing x with t, its shadow is its exact value
+func NewPosit32Traced(t *Tracer, x Posit32) Posit32Traced {
+	return Posit32Traced{impl: t.Trace(x.impl)}
+}
+
+// NewPosit32TracedFloat rounds f to a Posit32 and starts tracing it with f as the shadow
+func NewPosit32TracedFloat(t *Tracer, f *big.Float) Posit32Traced {
+	return Posit32Traced{impl: t.TraceFloat(32, 2, f)}
+}
+
+// Value returns the posit
+func (v Posit32Traced) Value() Posit32 { return Posit32{impl: v.impl.Value} }
+
+// Shadow returns the high precision value, nil if it is undefined
+func (v Posit32Traced) Shadow() *big.Float { return v.impl.Shadow }
+
+// Err returns the relative error of the posit compared to its shadow
+func (v Posit32Traced) Err() float64 { return v.impl.Err() }
+
+// Add returns v + x
+func (v Posit32Traced) Add(x Posit32Traced) Posit32Traced {
+	return Posit32Traced{impl: v.impl.Add(x.impl)}
+}
+
+// Sub returns v - x
+func (v Posit32Traced) Sub(x Posit32Traced) Posit32Traced {
+	return Posit32Traced{impl: v.impl.Sub(x.impl)}
+}
+
+// Mul returns v * x
+func (v Posit32Traced) Mul(x Posit32Traced) Posit32Traced {
+	return Posit32Traced{impl: v.impl.Mul(x.impl)}
+}
+
+// Div returns v / x
+func (v Posit32Traced) Div(x Posit32Traced) Posit32Traced {
+	return Posit32Traced{impl: v.impl.Div(x.impl)}
+}
+
+// Sqrt returns the square root of v
+func (v Posit32Traced) Sqrt() Posit32Traced { return Posit32Traced{impl: v.impl.Sqrt()} }
+
+// Neg returns -v
+func (v Posit32Traced) Neg() Posit32Traced { return Posit32Traced{impl: v.impl.Neg()} }
+
+// Posit64Traced is a Posit64 with a high precision shadow, see SlowTraced
+type Posit64Traced struct{ impl *SlowTraced }
+
+// NewPosit64Traced starts tracing x with t, its shadow is its exact value
+func NewPosit64Traced(t *Tracer, x Posit64) Posit64Traced {
+	return Posit64Traced{impl: t.Trace(x.impl)}
+}
+
+// NewPosit64TracedFloat rounds f to a Posit64 and starts tracing it with f as the shadow
+func NewPosit64TracedFloat(t *Tracer, f *big.Float) Posit64Traced {
+	return Posit64Traced{impl: t.TraceFloat(64, 3, f)}
+}
+
+// Value returns the posit
+func (v Posit64Traced) Value() Posit64 { return Posit64{impl: v.impl.Value} }
+
+// Shadow returns the high precision value, nil if it is undefined
+func (v Posit64Traced) Shadow() *big.Float { return v.impl.Shadow }
+
+// Err returns the relative error of the posit compared to its shadow
+func (v Posit64Traced) Err() float64 { return v.impl.Err() }
+
+// Add returns v + x
+func (v Posit64Traced) Add(x Posit64Traced) Posit64Traced {
+	return Posit64Traced{impl: v.impl.Add(x.impl)}
+}
+
+// Sub returns v - x
+func (v Posit64Traced) Sub(x Posit64Traced) Posit64Traced {
+	return Posit64Traced{impl: v.impl.Sub(x.impl)}
+}
+
+// Mul returns v * x
+func (v Posit64Traced) Mul(x Posit64Traced) Posit64Traced {
+	return Posit64Traced{impl: v.impl.Mul(x.impl)}
+}
+
+// Div returns v / x
+func (v Posit64Traced) Div(x Posit64Traced) Posit64Traced {
+	return Posit64Traced{impl: v.impl.Div(x.impl)}
+}
+
+// Sqrt returns the square root of v
+func (v Posit64Traced) Sqrt() Posit64Traced { return Posit64Traced{impl: v.impl.Sqrt()} }
+
+// Neg returns -v
+func (v Posit64Traced) Neg() Posit64Traced { return Posit64Traced{impl: v.impl.Neg()} }
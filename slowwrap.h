@@ -6,6 +6,30 @@ __COMMENT__ GLUE(NewPosit, NBITS) makes a new posit with NBITS bits and ES es bi
 func GLUE(NewPosit, NBITS)() POSIT_T { return POSIT_T{impl: NewSlowPosit(NBITS, ES)} }
 
 
+#define CONSTANT(_NAME_, _FUNC_, _DOC_) \
+    __COMMENT__ GLUE(POSIT_T, _NAME_) returns _DOC_ __NEWLINE__\
+    func GLUE(POSIT_T, _NAME_)() POSIT_T { return POSIT_T{impl: NewSlowPosit(NBITS, ES)._FUNC_()} }
+
+CONSTANT(NaR, NaR, NaR (Not a Real))
+
+CONSTANT(Zero, Zero, zero)
+
+CONSTANT(One, One, one)
+
+CONSTANT(MaxPos, Max, the largest positive posit)
+
+CONSTANT(MinPos, Min, the smallest positive posit)
+
+CONSTANT(Pi, Pi, pi correctly rounded)
+
+CONSTANT(E, E, e correctly rounded)
+
+CONSTANT(Ln2, Ln2, the natural log of 2 correctly rounded)
+
+CONSTANT(Sqrt2, Sqrt2, the square root of 2 correctly rounded)
+
+#undef CONSTANT
+
 __COMMENT__ Add takes the sum of two posits
 __COMMENT__ p.Add(x) creates a new posit z which is p+x, neither p nor x are altered
 func (p POSIT_T) Add(x POSIT_T) POSIT_T { return POSIT_T{impl: p.impl.Add(x.impl)} }
@@ -14,6 +38,8 @@ __COMMENT__ AddExact returns exactly the sum of two posits, represented as two
 __COMMENT__ more posits, the first result is a posit which is the sum truncated
 __COMMENT__ (round to negative infinity) and the second posit is the difference between
 __COMMENT__ the first posit and the true sum. That is to say true_sum = out2 + out1.
+__COMMENT__ In rare cases the difference needs more bits than the posit has, then it is
+__COMMENT__ rounded to nearest even.
 func (p POSIT_T) AddExact(x POSIT_T) (POSIT_T, POSIT_T) {
     res, diff := p.impl.AddExact(x.impl)
 	return POSIT_T{impl: res}, POSIT_T{impl: diff}
@@ -28,6 +54,8 @@ __COMMENT__ SubExact returns exactly the difference of two posits, represented a
 __COMMENT__ more posits, the first result is a posit which is the difference truncated
 __COMMENT__ (round to negative infinity) and the second posit is the difference between
 __COMMENT__ the first posit and the true sum. That is to say true_sum = out2 + out1.
+__COMMENT__ In rare cases the difference needs more bits than the posit has, then it is
+__COMMENT__ rounded to nearest even.
 func (p POSIT_T) SubExact(x POSIT_T) (POSIT_T, POSIT_T) {
     res, diff := p.impl.SubExact(x.impl)
 	return POSIT_T{impl: res}, POSIT_T{impl: diff}
@@ -53,7 +81,7 @@ func (p POSIT_T) Div(x POSIT_T) POSIT_T { return POSIT_T{impl: p.impl.Div(x.impl
 __COMMENT__ DivPromote takes the quotent of two posits
 __COMMENT__ p.DivPromote(x) creates a new posit z which is p/x, as the next larger posit size
 __COMMENT__ next larger means the bit width is doubled and the exponent size is increased by 1
-__COMMENT__ this function is guaranteed not to round
+__COMMENT__ the quotient is rounded to nearest even in the larger size
 func (p POSIT_T) DivPromote(x POSIT_T) BIGGER_T { return BIGGER_T{impl: p.impl.DivPromote(x.impl)} }
 #endif
 
@@ -76,7 +104,7 @@ func (p POSIT_T) FromUint(i UWORD) POSIT_T { return POSIT_T{impl: p.impl.FromUin
 __COMMENT__ Int outputs an SWORD representation of the value of the posit
 __COMMENT__ lost bits are rounded to nearest even and if the number is greater than or equal
 __COMMENT__ to two to the NBITS-1 power, the maximum SMAX for positive input or
-__COMMENT__ UMAX for negative input will return.
+__COMMENT__ -SMAX for negative input will return.
 __COMMENT__ Likewise if the number is less than or equal to 1/2 it will round down to zero.
 func (p POSIT_T) Int() SWORD {
     x := p.impl.Int()
@@ -91,9 +119,9 @@ func (p POSIT_T) Int() SWORD {
 
 __COMMENT__ Uint outputs a UWORD representation of the value of the posit, if the posit
 __COMMENT__ is less than or equal to 1/2, 0 will be returned and if the value is greater
-__COMMENT__ than two to the maxBits power, 0xffffffffffffffff will be returned.
+__COMMENT__ than UMAX, UMAX will be returned. Negative numbers return 0 and NaR returns the maximum.
 func (p POSIT_T) Uint() UWORD {
-    x := p.impl.Int()
+    x := p.impl.Uint()
     #if UMAX < 0xffffffffffffffff
     if x > UMAX {
         return UMAX
@@ -102,6 +130,112 @@ func (p POSIT_T) Uint() UWORD {
     return UWORD(x)
 }
 
+__COMMENT__ ToInt converts the posit to an SWORD rounding in the given mode, if the result is out
+__COMMENT__ of range it saturates to the minimum or maximum SWORD and ok is false.
+__COMMENT__ NaR returns 0 and false.
+func (p POSIT_T) ToInt(mode RoundingMode) (SWORD, bool) {
+    x, ok := p.impl.toInt(mode, NBITS)
+    return SWORD(x), ok
+}
+
+__COMMENT__ ToUint converts the posit to a UWORD rounding in the given mode, if the result is
+__COMMENT__ negative or greater than UMAX it saturates to 0 or UMAX and ok is false.
+__COMMENT__ NaR returns 0 and false.
+func (p POSIT_T) ToUint(mode RoundingMode) (UWORD, bool) {
+    x, ok := p.impl.toUint(mode, NBITS)
+    return UWORD(x), ok
+}
+
+#define TO_INT(_BITS_) \
+    __COMMENT__ GLUE(ToInt, _BITS_) converts the posit to an GLUE(int, _BITS_) like ToInt __NEWLINE__\
+    func (p POSIT_T) GLUE(ToInt, _BITS_)(mode RoundingMode) (GLUE(int, _BITS_), bool) { \
+        x, ok := p.impl.toInt(mode, _BITS_); return GLUE(int, _BITS_)(x), ok } __NEWLINE__\
+    __NEWLINE__\
+    __COMMENT__ GLUE(ToUint, _BITS_) converts the posit to a GLUE(uint, _BITS_) like ToUint __NEWLINE__\
+    func (p POSIT_T) GLUE(ToUint, _BITS_)(mode RoundingMode) (GLUE(uint, _BITS_), bool) { \
+        x, ok := p.impl.toUint(mode, _BITS_); return GLUE(uint, _BITS_)(x), ok }
+
+TO_INT(8)
+
+TO_INT(16)
+
+TO_INT(32)
+
+TO_INT(64)
+
+#define FIXED(_BITS_) \
+    __COMMENT__ GLUE(ToFixed, _BITS_) converts the posit to an GLUE(int, _BITS_) fixed point number with fracBits __NEWLINE__\
+    __COMMENT__ fraction bits rounding in the given mode, if the result is out of range it saturates __NEWLINE__\
+    __COMMENT__ to the minimum or maximum and ok is false. NaR returns 0 and false. __NEWLINE__\
+    func (p POSIT_T) GLUE(ToFixed, _BITS_)(fracBits uint, mode RoundingMode) (GLUE(int, _BITS_), bool) { \
+        x, ok := p.impl.toFixed(mode, fracBits, _BITS_); return GLUE(int, _BITS_)(x), ok } __NEWLINE__\
+    __NEWLINE__\
+    __COMMENT__ GLUE(FromFixed, _BITS_) creates a new posit which is set to the value of the fixed point number __NEWLINE__\
+    __COMMENT__ v with fracBits fraction bits rounded to nearest even, the result is true if the __NEWLINE__\
+    __COMMENT__ number could be converted without rounding. __NEWLINE__\
+    __COMMENT__ p.GLUE(FromFixed, _BITS_)() outputs a new posit z, p is not altered __NEWLINE__\
+    func (p POSIT_T) GLUE(FromFixed, _BITS_)(v GLUE(int, _BITS_), fracBits uint) (POSIT_T, bool) { \
+        x, exact := p.impl.FromFixed(int64(v), fracBits); return POSIT_T{impl: x}, exact } __NEWLINE__\
+    __NEWLINE__\
+    __COMMENT__ GLUE(POSIT_T, GLUE(SliceToFixed, _BITS_)) converts every posit in src with GLUE(ToFixed, _BITS_) and stores __NEWLINE__\
+    __COMMENT__ the results in dst, it returns the number of values which saturated or were NaR. __NEWLINE__\
+    __COMMENT__ if dst and src are not the same length, this function will panic __NEWLINE__\
+    func GLUE(POSIT_T, GLUE(SliceToFixed, _BITS_))(dst []GLUE(int, _BITS_), src []POSIT_T, fracBits uint, mode RoundingMode) int { \
+        if len(dst) != len(src) { panic("unexpected length of input"); }; \
+        bad := 0; \
+        for i, p := range src { \
+            var ok bool; \
+            if dst[i], ok = p.GLUE(ToFixed, _BITS_)(fracBits, mode); !ok { bad++ }; \
+        }; \
+        return bad; \
+    } __NEWLINE__\
+    __NEWLINE__\
+    __COMMENT__ GLUE(POSIT_T, GLUE(SliceFromFixed, _BITS_)) converts every fixed point number in src with __NEWLINE__\
+    __COMMENT__ GLUE(FromFixed, _BITS_) and stores the results in dst, it returns the number of values which were rounded. __NEWLINE__\
+    __COMMENT__ if dst and src are not the same length, this function will panic __NEWLINE__\
+    func GLUE(POSIT_T, GLUE(SliceFromFixed, _BITS_))(dst []POSIT_T, src []GLUE(int, _BITS_), fracBits uint) int { \
+        if len(dst) != len(src) { panic("unexpected length of input"); }; \
+        p := GLUE(NewPosit, NBITS)(); \
+        rounded := 0; \
+        for i, v := range src { \
+            var exact bool; \
+            if dst[i], exact = p.GLUE(FromFixed, _BITS_)(v, fracBits); !exact { rounded++ }; \
+        }; \
+        return rounded; \
+    }
+
+FIXED(16)
+
+FIXED(32)
+
+FIXED(64)
+
+__COMMENT__ FromBigInt creates a new posit which is set to the value of i rounded to nearest even,
+__COMMENT__ numbers larger than maxpos saturate to maxpos.
+__COMMENT__ p.FromBigInt() outputs a new posit z, p is not altered
+func (p POSIT_T) FromBigInt(i *big.Int) POSIT_T { return POSIT_T{impl: p.impl.FromBigInt(i)} }
+
+__COMMENT__ FromRat creates a new posit which is set to the value of r rounded to nearest even,
+__COMMENT__ numbers larger than maxpos saturate to maxpos and numbers which are not zero but
+__COMMENT__ smaller than minpos become minpos.
+__COMMENT__ p.FromRat() outputs a new posit z, p is not altered
+func (p POSIT_T) FromRat(r *big.Rat) POSIT_T { return POSIT_T{impl: p.impl.FromRat(r)} }
+
+__COMMENT__ FromBigFloat creates a new posit which is set to the value of f rounded to nearest
+__COMMENT__ even, with the same saturation as FromRat, an infinite f becomes NaR.
+__COMMENT__ p.FromBigFloat() outputs a new posit z, p is not altered
+func (p POSIT_T) FromBigFloat(f *big.Float) POSIT_T { return POSIT_T{impl: p.impl.FromBigFloat(f)} }
+
+__COMMENT__ BigInt returns the value of the posit rounded to the nearest integer, ties to even,
+__COMMENT__ or nil if the posit is NaR. Unlike Int it never saturates.
+func (p POSIT_T) BigInt() *big.Int { return p.impl.BigInt() }
+
+__COMMENT__ Rat returns the exact value of the posit, or nil if it is NaR
+func (p POSIT_T) Rat() *big.Rat { return p.impl.Rat() }
+
+__COMMENT__ BigFloat returns the exact value of the posit, or nil if it is NaR
+func (p POSIT_T) BigFloat() *big.Float { return p.impl.BigFloat() }
+
 //__COMMENT__ binary manipulation ////
 
 __COMMENT__ Exp outputs the exponent of the posit, specifically the number z for which
@@ -138,14 +272,16 @@ func (p POSIT_T) Up() BIGGER_T {
 __COMMENT__ Down returns the same number down-casted to the next smaller posit, see Up() for the
 __COMMENT__ definition of larger and smaller.
 func (p POSIT_T) Down() SMALLER_T {
-    return SMALLER_T{impl:p.impl.Up()}
+    return SMALLER_T{impl:p.impl.Down()}
 }
 #endif
 
 __COMMENT__ Bits outputs a UWORD containing the raw binary format of the posit
 func (p POSIT_T) Bits() UWORD {
     words := p.impl.Bits.Bits()
-    if len(words) == 1 {
+    if len(words) == 0 {
+        return 0
+    } else if len(words) == 1 {
         return UWORD(words[0])
     } else if len(words) == 2 {
         if bits.UintSize != 32 {
@@ -162,7 +298,137 @@ func (p POSIT_T) SetBits(bits UWORD) POSIT_T {
     return POSIT_T{impl: &SlowPosit{es:p.impl.es, nbits:p.impl.nbits, Bits:bi}}
 }
 
+__COMMENT__ NextUp returns the smallest posit which is greater than p, the next posit after
+__COMMENT__ the largest posit is NaR and the next posit after NaR is also NaR.
+func (p POSIT_T) NextUp() POSIT_T { return POSIT_T{impl: p.impl.NextUp()} }
+
+__COMMENT__ NextDown returns the largest posit which is less than p, the next posit before
+__COMMENT__ the most negative posit is NaR and the next posit before NaR is also NaR.
+func (p POSIT_T) NextDown() POSIT_T { return POSIT_T{impl: p.impl.NextDown()} }
+
+__COMMENT__ ULP returns the unit in the last place of p as a posit, see SlowPosit.ULPFloat
+func (p POSIT_T) ULP() POSIT_T { return POSIT_T{impl: p.impl.ULP()} }
+
+__COMMENT__ ULPFloat returns the unit in the last place of p exactly, see SlowPosit.ULPFloat
+func (p POSIT_T) ULPFloat() *big.Float { return p.impl.ULPFloat() }
+
+__COMMENT__ Distance returns the number of steps from p to x, for example the distance between a
+__COMMENT__ posit and p.NextUp() is 1. The distance to NaR is not defined and will panic.
+func (p POSIT_T) Distance(x POSIT_T) UWORD { return UWORD(p.impl.Distance(x.impl).Uint64()) }
+
+__COMMENT__ Decode breaks the posit down into its bit fields, see SlowFields
+func (p POSIT_T) Decode() PositFields { return p.impl.Decode().positFields() }
+
+__COMMENT__ Encode creates a new posit from bit fields, rounding to nearest even, see SlowPosit.Encode
+__COMMENT__ p.Encode() outputs a new posit z, p is not altered
+func (p POSIT_T) Encode(f PositFields) POSIT_T { return POSIT_T{impl: p.impl.Encode(f.slowFields())} }
+
 __COMMENT__ Clone makes a copy of a posit
 func (p POSIT_T) Clone() POSIT_T {
     return POSIT_T{impl: p.impl.Clone()}
 }
+
+__COMMENT__ slow returns the SlowPosit behind p, the zero value of POSIT_T is treated as zero so
+__COMMENT__ that structures containing posits can be encoded without being initialized.
+func (p POSIT_T) slow() *SlowPosit {
+    if p.impl == nil {
+        return NewSlowPosit(NBITS, ES)
+    }
+    return p.impl
+}
+
+__COMMENT__ MarshalBinary outputs the bits of the posit in little endian order
+func (p POSIT_T) MarshalBinary() ([]byte, error) { return p.slow().bytes(binary.LittleEndian), nil }
+
+__COMMENT__ MarshalBinaryOrder outputs the bits of the posit in the specified order
+func (p POSIT_T) MarshalBinaryOrder(order binary.ByteOrder) []byte { return p.slow().bytes(order) }
+
+__COMMENT__ UnmarshalBinary sets the posit from bits in little endian order
+func (p *POSIT_T) UnmarshalBinary(data []byte) error {
+    return p.UnmarshalBinaryOrder(data, binary.LittleEndian)
+}
+
+__COMMENT__ UnmarshalBinaryOrder sets the posit from bits in the specified order
+func (p *POSIT_T) UnmarshalBinaryOrder(data []byte, order binary.ByteOrder) error {
+    out := NewSlowPosit(NBITS, ES)
+    if err := out.setBytes(data, order); err != nil {
+        return err
+    }
+    *p = POSIT_T{impl: out}
+    return nil
+}
+
+__COMMENT__ MarshalText outputs the shortest decimal which converts back to the same posit
+func (p POSIT_T) MarshalText() ([]byte, error) { return []byte(p.slow().decimal()), nil }
+
+__COMMENT__ UnmarshalText sets the posit from a decimal number rounded to nearest even, a hex
+__COMMENT__ string of bits beginning with 0x, or NaR.
+func (p *POSIT_T) UnmarshalText(text []byte) error {
+    out := NewSlowPosit(NBITS, ES)
+    if err := out.parse(string(text)); err != nil {
+        return err
+    }
+    *p = POSIT_T{impl: out}
+    return nil
+}
+
+__COMMENT__ MarshalJSON outputs the posit as a string in the format DefaultJSONFormat
+func (p POSIT_T) MarshalJSON() ([]byte, error) { return p.slow().marshalJSON(DefaultJSONFormat) }
+
+__COMMENT__ MarshalJSONFormat outputs the posit as a string in the format f
+func (p POSIT_T) MarshalJSONFormat(f JSONFormat) ([]byte, error) { return p.slow().marshalJSON(f) }
+
+__COMMENT__ GLUE(POSIT_T, JSONHex) is a POSIT_T which MarshalJSON always writes in the JSONHex format,
+__COMMENT__ whatever DefaultJSONFormat is, use it for struct fields which need a fixed format.
+type GLUE(POSIT_T, JSONHex) struct{ POSIT_T }
+
+__COMMENT__ MarshalJSON outputs the posit as a string in the format JSONHex
+func (p GLUE(POSIT_T, JSONHex)) MarshalJSON() ([]byte, error) { return p.slow().marshalJSON(JSONHex) }
+
+__COMMENT__ GLUE(POSIT_T, JSONDecimal) is a POSIT_T which MarshalJSON always writes in the JSONDecimal
+__COMMENT__ format, whatever DefaultJSONFormat is, use it for struct fields which need a fixed format.
+type GLUE(POSIT_T, JSONDecimal) struct{ POSIT_T }
+
+__COMMENT__ MarshalJSON outputs the posit as a string in the format JSONDecimal
+func (p GLUE(POSIT_T, JSONDecimal)) MarshalJSON() ([]byte, error) { return p.slow().marshalJSON(JSONDecimal) }
+
+__COMMENT__ UnmarshalJSON sets the posit from a string in any JSONFormat or from a number
+func (p *POSIT_T) UnmarshalJSON(data []byte) error {
+    if string(data) == "null" {
+        return nil
+    }
+    out := NewSlowPosit(NBITS, ES)
+    if err := out.unmarshalJSON(data); err != nil {
+        return err
+    }
+    *p = POSIT_T{impl: out}
+    return nil
+}
+
+__COMMENT__ Value implements driver.Valuer, the posit is stored in the format DefaultSQLFormat
+func (p POSIT_T) Value() (driver.Value, error) { return p.slow().sqlValue(DefaultSQLFormat) }
+
+__COMMENT__ Scan implements sql.Scanner, it accepts an integer containing the bits of the posit
+__COMMENT__ either signed or unsigned, a string in any format accepted by UnmarshalText, or a float.
+func (p *POSIT_T) Scan(src any) error {
+    out := NewSlowPosit(NBITS, ES)
+    if err := out.scan(src); err != nil {
+        return err
+    }
+    *p = POSIT_T{impl: out}
+    return nil
+}
+
+__COMMENT__ GLUE(POSIT_T, SQLBits) is a POSIT_T which Value always stores in the format SQLBits,
+__COMMENT__ whatever DefaultSQLFormat is, Scan accepts every format.
+type GLUE(POSIT_T, SQLBits) struct{ POSIT_T }
+
+__COMMENT__ Value implements driver.Valuer, the posit is stored in the format SQLBits
+func (p GLUE(POSIT_T, SQLBits)) Value() (driver.Value, error) { return p.slow().sqlValue(SQLBits) }
+
+__COMMENT__ GLUE(POSIT_T, SQLDecimal) is a POSIT_T which Value always stores in the format SQLDecimal,
+__COMMENT__ whatever DefaultSQLFormat is, Scan accepts every format.
+type GLUE(POSIT_T, SQLDecimal) struct{ POSIT_T }
+
+__COMMENT__ Value implements driver.Valuer, the posit is stored in the format SQLDecimal
+func (p GLUE(POSIT_T, SQLDecimal)) Value() (driver.Value, error) { return p.slow().sqlValue(SQLDecimal) }
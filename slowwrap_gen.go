@@ -1,6 +1,8 @@
 package goposit
 
 import (
+	"database/sql/driver"
+	"encoding/binary"
 	"math/big"
 	"math/bits"
 )
@@ -11,6 +13,33 @@ type Posit8 struct{ impl *SlowPosit }
 // NewPosit8 makes a new posit with 8 bits and 0 es bits, the initial value is zero
 func NewPosit8() Posit8 { return Posit8{impl: NewSlowPosit(8, 0)} }
 
+// Posit8NaR returns NaR (Not a Real)
+func Posit8NaR() Posit8 { return Posit8{impl: NewSlowPosit(8, 0).NaR()} }
+
+// Posit8Zero returns zero
+func Posit8Zero() Posit8 { return Posit8{impl: NewSlowPosit(8, 0).Zero()} }
+
+// Posit8One returns one
+func Posit8One() Posit8 { return Posit8{impl: NewSlowPosit(8, 0).One()} }
+
+// Posit8MaxPos returns the largest positive posit
+func Posit8MaxPos() Posit8 { return Posit8{impl: NewSlowPosit(8, 0).Max()} }
+
+// Posit8MinPos returns the smallest positive posit
+func Posit8MinPos() Posit8 { return Posit8{impl: NewSlowPosit(8, 0).Min()} }
+
+// Posit8Pi returns pi correctly rounded
+func Posit8Pi() Posit8 { return Posit8{impl: NewSlowPosit(8, 0).Pi()} }
+
+// Posit8E returns e correctly rounded
+func Posit8E() Posit8 { return Posit8{impl: NewSlowPosit(8, 0).E()} }
+
+// Posit8Ln2 returns the natural log of 2 correctly rounded
+func Posit8Ln2() Posit8 { return Posit8{impl: NewSlowPosit(8, 0).Ln2()} }
+
+// Posit8Sqrt2 returns the square root of 2 correctly rounded
+func Posit8Sqrt2() Posit8 { return Posit8{impl: NewSlowPosit(8, 0).Sqrt2()} }
+
 // Add takes the sum of two posits
 // p.Add(x) creates a new posit z which is p+x, neither p nor x are altered
 func (p Posit8) Add(x Posit8) Posit8 { return Posit8{impl: p.impl.Add(x.impl)} }
@@ -19,6 +48,8 @@ func (p Posit8) Add(x Posit8) Posit8 { return Posit8{impl: p.impl.Add(x.impl)} }
 // more posits, the first result is a posit which is the sum truncated
 // (round to negative infinity) and the second posit is the difference between
 // the first posit and the true sum. That is to say true_sum = out2 + out1.
+// In rare cases the difference needs more bits than the posit has, then it is
+// rounded to nearest even.
 func (p Posit8) AddExact(x Posit8) (Posit8, Posit8) {
 	res, diff := p.impl.AddExact(x.impl)
 	return Posit8{impl: res}, Posit8{impl: diff}
@@ -32,6 +63,8 @@ func (p Posit8) Sub(x Posit8) Posit8 { return Posit8{impl: p.impl.Sub(x.impl)} }
 // more posits, the first result is a posit which is the difference truncated
 // (round to negative infinity) and the second posit is the difference between
 // the first posit and the true sum. That is to say true_sum = out2 + out1.
+// In rare cases the difference needs more bits than the posit has, then it is
+// rounded to nearest even.
 func (p Posit8) SubExact(x Posit8) (Posit8, Posit8) {
 	res, diff := p.impl.SubExact(x.impl)
 	return Posit8{impl: res}, Posit8{impl: diff}
@@ -54,7 +87,7 @@ func (p Posit8) Div(x Posit8) Posit8 { return Posit8{impl: p.impl.Div(x.impl)} }
 // DivPromote takes the quotent of two posits
 // p.DivPromote(x) creates a new posit z which is p/x, as the next larger posit size
 // next larger means the bit width is doubled and the exponent size is increased by 1
-// this function is guaranteed not to round
+// the quotient is rounded to nearest even in the larger size
 func (p Posit8) DivPromote(x Posit8) Posit16 { return Posit16{impl: p.impl.DivPromote(x.impl)} }
 
 // Sqrt finds the square root of a posit
@@ -74,7 +107,7 @@ func (p Posit8) FromUint(i uint8) Posit8 { return Posit8{impl: p.impl.FromUint(u
 // Int outputs an int8 representation of the value of the posit
 // lost bits are rounded to nearest even and if the number is greater than or equal
 // to two to the 8 -1 power, the maximum 0x7f for positive input or
-// 0xff for negative input will return.
+// -0x7f for negative input will return.
 // Likewise if the number is less than or equal to 1/2 it will round down to zero.
 func (p Posit8) Int() int8 {
 	x := p.impl.Int()
@@ -89,17 +122,261 @@ func (p Posit8) Int() int8 {
 
 // Uint outputs a uint8 representation of the value of the posit, if the posit
 // is less than or equal to 1/2, 0 will be returned and if the value is greater
-// than two to the maxBits power, 0xffffffffffffffff will be returned.
+// than 0xff, 0xff will be returned. Negative numbers return 0 and NaR returns the maximum.
 func (p Posit8) Uint() uint8 {
-	x := p.impl.Int()
-
+	x := p.impl.Uint()
 	if x > 0xff {
 		return 0xff
 	}
-
 	return uint8(x)
 }
 
+// ToInt converts the posit to an int8 rounding in the given mode, if the result is out
+// of range it saturates to the minimum or maximum int8 and ok is false.
+// NaR returns 0 and false.
+func (p Posit8) ToInt(mode RoundingMode) (int8, bool) {
+	x, ok := p.impl.toInt(mode, 8)
+	return int8(x), ok
+}
+
+// ToUint converts the posit to a uint8 rounding in the given mode, if the result is
+// negative or greater than 0xff it saturates to 0 or 0xff and ok is false.
+// NaR returns 0 and false.
+func (p Posit8) ToUint(mode RoundingMode) (uint8, bool) {
+	x, ok := p.impl.toUint(mode, 8)
+	return uint8(x), ok
+}
+
+// ToInt8 converts the posit to an int8 like ToInt
+func (p Posit8) ToInt8(mode RoundingMode) (int8, bool) {
+	x, ok := p.impl.toInt(mode, 8)
+	return int8(x), ok
+}
+
+// ToUint8 converts the posit to a uint8 like ToUint
+func (p Posit8) ToUint8(mode RoundingMode) (uint8, bool) {
+	x, ok := p.impl.toUint(mode, 8)
+	return uint8(x), ok
+}
+
+// ToInt16 converts the posit to an int16 like ToInt
+func (p Posit8) ToInt16(mode RoundingMode) (int16, bool) {
+	x, ok := p.impl.toInt(mode, 16)
+	return int16(x), ok
+}
+
+// ToUint16 converts the posit to a uint16 like ToUint
+func (p Posit8) ToUint16(mode RoundingMode) (uint16, bool) {
+	x, ok := p.impl.toUint(mode, 16)
+	return uint16(x), ok
+}
+
+// ToInt32 converts the posit to an int32 like ToInt
+func (p Posit8) ToInt32(mode RoundingMode) (int32, bool) {
+	x, ok := p.impl.toInt(mode, 32)
+	return int32(x), ok
+}
+
+// ToUint32 converts the posit to a uint32 like ToUint
+func (p Posit8) ToUint32(mode RoundingMode) (uint32, bool) {
+	x, ok := p.impl.toUint(mode, 32)
+	return uint32(x), ok
+}
+
+// ToInt64 converts the posit to an int64 like ToInt
+func (p Posit8) ToInt64(mode RoundingMode) (int64, bool) {
+	x, ok := p.impl.toInt(mode, 64)
+	return int64(x), ok
+}
+
+// ToUint64 converts the posit to a uint64 like ToUint
+func (p Posit8) ToUint64(mode RoundingMode) (uint64, bool) {
+	x, ok := p.impl.toUint(mode, 64)
+	return uint64(x), ok
+}
+
+// ToFixed16 converts the posit to an int16 fixed point number with fracBits
+// fraction bits rounding in the given mode, if the result is out of range it saturates
+// to the minimum or maximum and ok is false. NaR returns 0 and false.
+func (p Posit8) ToFixed16(fracBits uint, mode RoundingMode) (int16, bool) {
+	x, ok := p.impl.toFixed(mode, fracBits, 16)
+	return int16(x), ok
+}
+
+// FromFixed16 creates a new posit which is set to the value of the fixed point number
+// v with fracBits fraction bits rounded to nearest even, the result is true if the
+// number could be converted without rounding.
+// p.FromFixed16() outputs a new posit z, p is not altered
+func (p Posit8) FromFixed16(v int16, fracBits uint) (Posit8, bool) {
+	x, exact := p.impl.FromFixed(int64(v), fracBits)
+	return Posit8{impl: x}, exact
+}
+
+// Posit8SliceToFixed16 converts every posit in src with ToFixed16 and stores
+// the results in dst, it returns the number of values which saturated or were NaR.
+// if dst and src are not the same length, this function will panic
+func Posit8SliceToFixed16(dst []int16, src []Posit8, fracBits uint, mode RoundingMode) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	bad := 0
+	for i, p := range src {
+		var ok bool
+		if dst[i], ok = p.ToFixed16(fracBits, mode); !ok {
+			bad++
+		}
+	}
+	return bad
+}
+
+// Posit8SliceFromFixed16 converts every fixed point number in src with
+// FromFixed16 and stores the results in dst, it returns the number of values which were rounded.
+// if dst and src are not the same length, this function will panic
+func Posit8SliceFromFixed16(dst []Posit8, src []int16, fracBits uint) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	p := NewPosit8()
+	rounded := 0
+	for i, v := range src {
+		var exact bool
+		if dst[i], exact = p.FromFixed16(v, fracBits); !exact {
+			rounded++
+		}
+	}
+	return rounded
+}
+
+// ToFixed32 converts the posit to an int32 fixed point number with fracBits
+// fraction bits rounding in the given mode, if the result is out of range it saturates
+// to the minimum or maximum and ok is false. NaR returns 0 and false.
+func (p Posit8) ToFixed32(fracBits uint, mode RoundingMode) (int32, bool) {
+	x, ok := p.impl.toFixed(mode, fracBits, 32)
+	return int32(x), ok
+}
+
+// FromFixed32 creates a new posit which is set to the value of the fixed point number
+// v with fracBits fraction bits rounded to nearest even, the result is true if the
+// number could be converted without rounding.
+// p.FromFixed32() outputs a new posit z, p is not altered
+func (p Posit8) FromFixed32(v int32, fracBits uint) (Posit8, bool) {
+	x, exact := p.impl.FromFixed(int64(v), fracBits)
+	return Posit8{impl: x}, exact
+}
+
+// Posit8SliceToFixed32 converts every posit in src with ToFixed32 and stores
+// the results in dst, it returns the number of values which saturated or were NaR.
+// if dst and src are not the same length, this function will panic
+func Posit8SliceToFixed32(dst []int32, src []Posit8, fracBits uint, mode RoundingMode) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	bad := 0
+	for i, p := range src {
+		var ok bool
+		if dst[i], ok = p.ToFixed32(fracBits, mode); !ok {
+			bad++
+		}
+	}
+	return bad
+}
+
+// Posit8SliceFromFixed32 converts every fixed point number in src with
+// FromFixed32 and stores the results in dst, it returns the number of values which were rounded.
+// if dst and src are not the same length, this function will panic
+func Posit8SliceFromFixed32(dst []Posit8, src []int32, fracBits uint) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	p := NewPosit8()
+	rounded := 0
+	for i, v := range src {
+		var exact bool
+		if dst[i], exact = p.FromFixed32(v, fracBits); !exact {
+			rounded++
+		}
+	}
+	return rounded
+}
+
+// ToFixed64 converts the posit to an int64 fixed point number with fracBits
+// fraction bits rounding in the given mode, if the result is out of range it saturates
+// to the minimum or maximum and ok is false. NaR returns 0 and false.
+func (p Posit8) ToFixed64(fracBits uint, mode RoundingMode) (int64, bool) {
+	x, ok := p.impl.toFixed(mode, fracBits, 64)
+	return int64(x), ok
+}
+
+// FromFixed64 creates a new posit which is set to the value of the fixed point number
+// v with fracBits fraction bits rounded to nearest even, the result is true if the
+// number could be converted without rounding.
+// p.FromFixed64() outputs a new posit z, p is not altered
+func (p Posit8) FromFixed64(v int64, fracBits uint) (Posit8, bool) {
+	x, exact := p.impl.FromFixed(int64(v), fracBits)
+	return Posit8{impl: x}, exact
+}
+
+// Posit8SliceToFixed64 converts every posit in src with ToFixed64 and stores
+// the results in dst, it returns the number of values which saturated or were NaR.
+// if dst and src are not the same length, this function will panic
+func Posit8SliceToFixed64(dst []int64, src []Posit8, fracBits uint, mode RoundingMode) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	bad := 0
+	for i, p := range src {
+		var ok bool
+		if dst[i], ok = p.ToFixed64(fracBits, mode); !ok {
+			bad++
+		}
+	}
+	return bad
+}
+
+// Posit8SliceFromFixed64 converts every fixed point number in src with
+// FromFixed64 and stores the results in dst, it returns the number of values which were rounded.
+// if dst and src are not the same length, this function will panic
+func Posit8SliceFromFixed64(dst []Posit8, src []int64, fracBits uint) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	p := NewPosit8()
+	rounded := 0
+	for i, v := range src {
+		var exact bool
+		if dst[i], exact = p.FromFixed64(v, fracBits); !exact {
+			rounded++
+		}
+	}
+	return rounded
+}
+
+// FromBigInt creates a new posit which is set to the value of i rounded to nearest even,
+// numbers larger than maxpos saturate to maxpos.
+// p.FromBigInt() outputs a new posit z, p is not altered
+func (p Posit8) FromBigInt(i *big.Int) Posit8 { return Posit8{impl: p.impl.FromBigInt(i)} }
+
+// FromRat creates a new posit which is set to the value of r rounded to nearest even,
+// numbers larger than maxpos saturate to maxpos and numbers which are not zero but
+// smaller than minpos become minpos.
+// p.FromRat() outputs a new posit z, p is not altered
+func (p Posit8) FromRat(r *big.Rat) Posit8 { return Posit8{impl: p.impl.FromRat(r)} }
+
+// FromBigFloat creates a new posit which is set to the value of f rounded to nearest
+// even, with the same saturation as FromRat, an infinite f becomes NaR.
+// p.FromBigFloat() outputs a new posit z, p is not altered
+func (p Posit8) FromBigFloat(f *big.Float) Posit8 { return Posit8{impl: p.impl.FromBigFloat(f)} }
+
+// BigInt returns the value of the posit rounded to the nearest integer, ties to even,
+// or nil if the posit is NaR. Unlike Int it never saturates.
+func (p Posit8) BigInt() *big.Int { return p.impl.BigInt() }
+
+// Rat returns the exact value of the posit, or nil if it is NaR
+func (p Posit8) Rat() *big.Rat { return p.impl.Rat() }
+
+// BigFloat returns the exact value of the posit, or nil if it is NaR
+func (p Posit8) BigFloat() *big.Float { return p.impl.BigFloat() }
+
 // Exp outputs the exponent of the posit, specifically the number z for which
 // 0.5*2**z <= positValue < 1*2**z
 func (p Posit8) Exp() int8 {
@@ -131,7 +408,9 @@ func (p Posit8) Up() Posit16 {
 // Bits outputs a uint8 containing the raw binary format of the posit
 func (p Posit8) Bits() uint8 {
 	words := p.impl.Bits.Bits()
-	if len(words) == 1 {
+	if len(words) == 0 {
+		return 0
+	} else if len(words) == 1 {
 		return uint8(words[0])
 	} else if len(words) == 2 {
 		if bits.UintSize != 32 {
@@ -148,17 +427,174 @@ func (p Posit8) SetBits(bits uint8) Posit8 {
 	return Posit8{impl: &SlowPosit{es: p.impl.es, nbits: p.impl.nbits, Bits: bi}}
 }
 
+// NextUp returns the smallest posit which is greater than p, the next posit after
+// the largest posit is NaR and the next posit after NaR is also NaR.
+func (p Posit8) NextUp() Posit8 { return Posit8{impl: p.impl.NextUp()} }
+
+// NextDown returns the largest posit which is less than p, the next posit before
+// the most negative posit is NaR and the next posit before NaR is also NaR.
+func (p Posit8) NextDown() Posit8 { return Posit8{impl: p.impl.NextDown()} }
+
+// ULP returns the unit in the last place of p as a posit, see SlowPosit.ULPFloat
+func (p Posit8) ULP() Posit8 { return Posit8{impl: p.impl.ULP()} }
+
+// ULPFloat returns the unit in the last place of p exactly, see SlowPosit.ULPFloat
+func (p Posit8) ULPFloat() *big.Float { return p.impl.ULPFloat() }
+
+// Distance returns the number of steps from p to x, for example the distance between a
+// posit and p.NextUp() is 1. The distance to NaR is not defined and will panic.
+func (p Posit8) Distance(x Posit8) uint8 { return uint8(p.impl.Distance(x.impl).Uint64()) }
+
+// Decode breaks the posit down into its bit fields, see SlowFields
+func (p Posit8) Decode() PositFields { return p.impl.Decode().positFields() }
+
+// Encode creates a new posit from bit fields, rounding to nearest even, see SlowPosit.Encode
+// p.Encode() outputs a new posit z, p is not altered
+func (p Posit8) Encode(f PositFields) Posit8 { return Posit8{impl: p.impl.Encode(f.slowFields())} }
+
 // Clone makes a copy of a posit
 func (p Posit8) Clone() Posit8 {
 	return Posit8{impl: p.impl.Clone()}
 }
 
+// slow returns the SlowPosit behind p, the zero value of Posit8 is treated as zero so
+// that structures containing posits can be encoded without being initialized.
+func (p Posit8) slow() *SlowPosit {
+	if p.impl == nil {
+		return NewSlowPosit(8, 0)
+	}
+	return p.impl
+}
+
+// MarshalBinary outputs the bits of the posit in little endian order
+func (p Posit8) MarshalBinary() ([]byte, error) { return p.slow().bytes(binary.LittleEndian), nil }
+
+// MarshalBinaryOrder outputs the bits of the posit in the specified order
+func (p Posit8) MarshalBinaryOrder(order binary.ByteOrder) []byte { return p.slow().bytes(order) }
+
+// UnmarshalBinary sets the posit from bits in little endian order
+func (p *Posit8) UnmarshalBinary(data []byte) error {
+	return p.UnmarshalBinaryOrder(data, binary.LittleEndian)
+}
+
+// UnmarshalBinaryOrder sets the posit from bits in the specified order
+func (p *Posit8) UnmarshalBinaryOrder(data []byte, order binary.ByteOrder) error {
+	out := NewSlowPosit(8, 0)
+	if err := out.setBytes(data, order); err != nil {
+		return err
+	}
+	*p = Posit8{impl: out}
+	return nil
+}
+
+// MarshalText outputs the shortest decimal which converts back to the same posit
+func (p Posit8) MarshalText() ([]byte, error) { return []byte(p.slow().decimal()), nil }
+
+// UnmarshalText sets the posit from a decimal number rounded to nearest even, a hex
+// string of bits beginning with 0x, or NaR.
+func (p *Posit8) UnmarshalText(text []byte) error {
+	out := NewSlowPosit(8, 0)
+	if err := out.parse(string(text)); err != nil {
+		return err
+	}
+	*p = Posit8{impl: out}
+	return nil
+}
+
+// MarshalJSON outputs the posit as a string in the format DefaultJSONFormat
+func (p Posit8) MarshalJSON() ([]byte, error) { return p.slow().marshalJSON(DefaultJSONFormat) }
+
+// MarshalJSONFormat outputs the posit as a string in the format f
+func (p Posit8) MarshalJSONFormat(f JSONFormat) ([]byte, error) { return p.slow().marshalJSON(f) }
+
+// Posit8JSONHex is a Posit8 which MarshalJSON always writes in the JSONHex format,
+// whatever DefaultJSONFormat is, use it for struct fields which need a fixed format.
+type Posit8JSONHex struct{ Posit8 }
+
+// MarshalJSON outputs the posit as a string in the format JSONHex
+func (p Posit8JSONHex) MarshalJSON() ([]byte, error) { return p.slow().marshalJSON(JSONHex) }
+
+// Posit8JSONDecimal is a Posit8 which MarshalJSON always writes in the JSONDecimal
+// format, whatever DefaultJSONFormat is, use it for struct fields which need a fixed format.
+type Posit8JSONDecimal struct{ Posit8 }
+
+// MarshalJSON outputs the posit as a string in the format JSONDecimal
+func (p Posit8JSONDecimal) MarshalJSON() ([]byte, error) { return p.slow().marshalJSON(JSONDecimal) }
+
+// UnmarshalJSON sets the posit from a string in any JSONFormat or from a number
+func (p *Posit8) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	out := NewSlowPosit(8, 0)
+	if err := out.unmarshalJSON(data); err != nil {
+		return err
+	}
+	*p = Posit8{impl: out}
+	return nil
+}
+
+// Value implements driver.Valuer, the posit is stored in the format DefaultSQLFormat
+func (p Posit8) Value() (driver.Value, error) { return p.slow().sqlValue(DefaultSQLFormat) }
+
+// Scan implements sql.Scanner, it accepts an integer containing the bits of the posit
+// either signed or unsigned, a string in any format accepted by UnmarshalText, or a float.
+func (p *Posit8) Scan(src any) error {
+	out := NewSlowPosit(8, 0)
+	if err := out.scan(src); err != nil {
+		return err
+	}
+	*p = Posit8{impl: out}
+	return nil
+}
+
+// Posit8SQLBits is a Posit8 which Value always stores in the format SQLBits,
+// whatever DefaultSQLFormat is, Scan accepts every format.
+type Posit8SQLBits struct{ Posit8 }
+
+// Value implements driver.Valuer, the posit is stored in the format SQLBits
+func (p Posit8SQLBits) Value() (driver.Value, error) { return p.slow().sqlValue(SQLBits) }
+
+// Posit8SQLDecimal is a Posit8 which Value always stores in the format SQLDecimal,
+// whatever DefaultSQLFormat is, Scan accepts every format.
+type Posit8SQLDecimal struct{ Posit8 }
+
+// Value implements driver.Valuer, the posit is stored in the format SQLDecimal
+func (p Posit8SQLDecimal) Value() (driver.Value, error) { return p.slow().sqlValue(SQLDecimal) }
+
 // Posit16 is an 16 bit posit with 1 exponent bits
 type Posit16 struct{ impl *SlowPosit }
 
 // NewPosit16 makes a new posit with 16 bits and 1 es bits, the initial value is zero
 func NewPosit16() Posit16 { return Posit16{impl: NewSlowPosit(16, 1)} }
 
+// Posit16NaR returns NaR (Not a Real)
+func Posit16NaR() Posit16 { return Posit16{impl: NewSlowPosit(16, 1).NaR()} }
+
+// Posit16Zero returns zero
+func Posit16Zero() Posit16 { return Posit16{impl: NewSlowPosit(16, 1).Zero()} }
+
+// Posit16One returns one
+func Posit16One() Posit16 { return Posit16{impl: NewSlowPosit(16, 1).One()} }
+
+// Posit16MaxPos returns the largest positive posit
+func Posit16MaxPos() Posit16 { return Posit16{impl: NewSlowPosit(16, 1).Max()} }
+
+// Posit16MinPos returns the smallest positive posit
+func Posit16MinPos() Posit16 { return Posit16{impl: NewSlowPosit(16, 1).Min()} }
+
+// Posit16Pi returns pi correctly rounded
+func Posit16Pi() Posit16 { return Posit16{impl: NewSlowPosit(16, 1).Pi()} }
+
+// Posit16E returns e correctly rounded
+func Posit16E() Posit16 { return Posit16{impl: NewSlowPosit(16, 1).E()} }
+
+// Posit16Ln2 returns the natural log of 2 correctly rounded
+func Posit16Ln2() Posit16 { return Posit16{impl: NewSlowPosit(16, 1).Ln2()} }
+
+// Posit16Sqrt2 returns the square root of 2 correctly rounded
+func Posit16Sqrt2() Posit16 { return Posit16{impl: NewSlowPosit(16, 1).Sqrt2()} }
+
 // Add takes the sum of two posits
 // p.Add(x) creates a new posit z which is p+x, neither p nor x are altered
 func (p Posit16) Add(x Posit16) Posit16 { return Posit16{impl: p.impl.Add(x.impl)} }
@@ -167,6 +603,8 @@ func (p Posit16) Add(x Posit16) Posit16 { return Posit16{impl: p.impl.Add(x.impl
 // more posits, the first result is a posit which is the sum truncated
 // (round to negative infinity) and the second posit is the difference between
 // the first posit and the true sum. That is to say true_sum = out2 + out1.
+// In rare cases the difference needs more bits than the posit has, then it is
+// rounded to nearest even.
 func (p Posit16) AddExact(x Posit16) (Posit16, Posit16) {
 	res, diff := p.impl.AddExact(x.impl)
 	return Posit16{impl: res}, Posit16{impl: diff}
@@ -180,6 +618,8 @@ func (p Posit16) Sub(x Posit16) Posit16 { return Posit16{impl: p.impl.Sub(x.impl
 // more posits, the first result is a posit which is the difference truncated
 // (round to negative infinity) and the second posit is the difference between
 // the first posit and the true sum. That is to say true_sum = out2 + out1.
+// In rare cases the difference needs more bits than the posit has, then it is
+// rounded to nearest even.
 func (p Posit16) SubExact(x Posit16) (Posit16, Posit16) {
 	res, diff := p.impl.SubExact(x.impl)
 	return Posit16{impl: res}, Posit16{impl: diff}
@@ -202,7 +642,7 @@ func (p Posit16) Div(x Posit16) Posit16 { return Posit16{impl: p.impl.Div(x.impl
 // DivPromote takes the quotent of two posits
 // p.DivPromote(x) creates a new posit z which is p/x, as the next larger posit size
 // next larger means the bit width is doubled and the exponent size is increased by 1
-// this function is guaranteed not to round
+// the quotient is rounded to nearest even in the larger size
 func (p Posit16) DivPromote(x Posit16) Posit32 { return Posit32{impl: p.impl.DivPromote(x.impl)} }
 
 // Sqrt finds the square root of a posit
@@ -222,7 +662,7 @@ func (p Posit16) FromUint(i uint16) Posit16 { return Posit16{impl: p.impl.FromUi
 // Int outputs an int16 representation of the value of the posit
 // lost bits are rounded to nearest even and if the number is greater than or equal
 // to two to the 16 -1 power, the maximum 0x7fff for positive input or
-// 0xffff for negative input will return.
+// -0x7fff for negative input will return.
 // Likewise if the number is less than or equal to 1/2 it will round down to zero.
 func (p Posit16) Int() int16 {
 	x := p.impl.Int()
@@ -237,17 +677,261 @@ func (p Posit16) Int() int16 {
 
 // Uint outputs a uint16 representation of the value of the posit, if the posit
 // is less than or equal to 1/2, 0 will be returned and if the value is greater
-// than two to the maxBits power, 0xffffffffffffffff will be returned.
+// than 0xffff, 0xffff will be returned. Negative numbers return 0 and NaR returns the maximum.
 func (p Posit16) Uint() uint16 {
-	x := p.impl.Int()
-
+	x := p.impl.Uint()
 	if x > 0xffff {
 		return 0xffff
 	}
-
 	return uint16(x)
 }
 
+// ToInt converts the posit to an int16 rounding in the given mode, if the result is out
+// of range it saturates to the minimum or maximum int16 and ok is false.
+// NaR returns 0 and false.
+func (p Posit16) ToInt(mode RoundingMode) (int16, bool) {
+	x, ok := p.impl.toInt(mode, 16)
+	return int16(x), ok
+}
+
+// ToUint converts the posit to a uint16 rounding in the given mode, if the result is
+// negative or greater than 0xffff it saturates to 0 or 0xffff and ok is false.
+// NaR returns 0 and false.
+func (p Posit16) ToUint(mode RoundingMode) (uint16, bool) {
+	x, ok := p.impl.toUint(mode, 16)
+	return uint16(x), ok
+}
+
+// ToInt8 converts the posit to an int8 like ToInt
+func (p Posit16) ToInt8(mode RoundingMode) (int8, bool) {
+	x, ok := p.impl.toInt(mode, 8)
+	return int8(x), ok
+}
+
+// ToUint8 converts the posit to a uint8 like ToUint
+func (p Posit16) ToUint8(mode RoundingMode) (uint8, bool) {
+	x, ok := p.impl.toUint(mode, 8)
+	return uint8(x), ok
+}
+
+// ToInt16 converts the posit to an int16 like ToInt
+func (p Posit16) ToInt16(mode RoundingMode) (int16, bool) {
+	x, ok := p.impl.toInt(mode, 16)
+	return int16(x), ok
+}
+
+// ToUint16 converts the posit to a uint16 like ToUint
+func (p Posit16) ToUint16(mode RoundingMode) (uint16, bool) {
+	x, ok := p.impl.toUint(mode, 16)
+	return uint16(x), ok
+}
+
+// ToInt32 converts the posit to an int32 like ToInt
+func (p Posit16) ToInt32(mode RoundingMode) (int32, bool) {
+	x, ok := p.impl.toInt(mode, 32)
+	return int32(x), ok
+}
+
+// ToUint32 converts the posit to a uint32 like ToUint
+func (p Posit16) ToUint32(mode RoundingMode) (uint32, bool) {
+	x, ok := p.impl.toUint(mode, 32)
+	return uint32(x), ok
+}
+
+// ToInt64 converts the posit to an int64 like ToInt
+func (p Posit16) ToInt64(mode RoundingMode) (int64, bool) {
+	x, ok := p.impl.toInt(mode, 64)
+	return int64(x), ok
+}
+
+// ToUint64 converts the posit to a uint64 like ToUint
+func (p Posit16) ToUint64(mode RoundingMode) (uint64, bool) {
+	x, ok := p.impl.toUint(mode, 64)
+	return uint64(x), ok
+}
+
+// ToFixed16 converts the posit to an int16 fixed point number with fracBits
+// fraction bits rounding in the given mode, if the result is out of range it saturates
+// to the minimum or maximum and ok is false. NaR returns 0 and false.
+func (p Posit16) ToFixed16(fracBits uint, mode RoundingMode) (int16, bool) {
+	x, ok := p.impl.toFixed(mode, fracBits, 16)
+	return int16(x), ok
+}
+
+// FromFixed16 creates a new posit which is set to the value of the fixed point number
+// v with fracBits fraction bits rounded to nearest even, the result is true if the
+// number could be converted without rounding.
+// p.FromFixed16() outputs a new posit z, p is not altered
+func (p Posit16) FromFixed16(v int16, fracBits uint) (Posit16, bool) {
+	x, exact := p.impl.FromFixed(int64(v), fracBits)
+	return Posit16{impl: x}, exact
+}
+
+// Posit16SliceToFixed16 converts every posit in src with ToFixed16 and stores
+// the results in dst, it returns the number of values which saturated or were NaR.
+// if dst and src are not the same length, this function will panic
+func Posit16SliceToFixed16(dst []int16, src []Posit16, fracBits uint, mode RoundingMode) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	bad := 0
+	for i, p := range src {
+		var ok bool
+		if dst[i], ok = p.ToFixed16(fracBits, mode); !ok {
+			bad++
+		}
+	}
+	return bad
+}
+
+// Posit16SliceFromFixed16 converts every fixed point number in src with
+// FromFixed16 and stores the results in dst, it returns the number of values which were rounded.
+// if dst and src are not the same length, this function will panic
+func Posit16SliceFromFixed16(dst []Posit16, src []int16, fracBits uint) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	p := NewPosit16()
+	rounded := 0
+	for i, v := range src {
+		var exact bool
+		if dst[i], exact = p.FromFixed16(v, fracBits); !exact {
+			rounded++
+		}
+	}
+	return rounded
+}
+
+// ToFixed32 converts the posit to an int32 fixed point number with fracBits
+// fraction bits rounding in the given mode, if the result is out of range it saturates
+// to the minimum or maximum and ok is false. NaR returns 0 and false.
+func (p Posit16) ToFixed32(fracBits uint, mode RoundingMode) (int32, bool) {
+	x, ok := p.impl.toFixed(mode, fracBits, 32)
+	return int32(x), ok
+}
+
+// FromFixed32 creates a new posit which is set to the value of the fixed point number
+// v with fracBits fraction bits rounded to nearest even, the result is true if the
+// number could be converted without rounding.
+// p.FromFixed32() outputs a new posit z, p is not altered
+func (p Posit16) FromFixed32(v int32, fracBits uint) (Posit16, bool) {
+	x, exact := p.impl.FromFixed(int64(v), fracBits)
+	return Posit16{impl: x}, exact
+}
+
+// Posit16SliceToFixed32 converts every posit in src with ToFixed32 and stores
+// the results in dst, it returns the number of values which saturated or were NaR.
+// if dst and src are not the same length, this function will panic
+func Posit16SliceToFixed32(dst []int32, src []Posit16, fracBits uint, mode RoundingMode) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	bad := 0
+	for i, p := range src {
+		var ok bool
+		if dst[i], ok = p.ToFixed32(fracBits, mode); !ok {
+			bad++
+		}
+	}
+	return bad
+}
+
+// Posit16SliceFromFixed32 converts every fixed point number in src with
+// FromFixed32 and stores the results in dst, it returns the number of values which were rounded.
+// if dst and src are not the same length, this function will panic
+func Posit16SliceFromFixed32(dst []Posit16, src []int32, fracBits uint) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	p := NewPosit16()
+	rounded := 0
+	for i, v := range src {
+		var exact bool
+		if dst[i], exact = p.FromFixed32(v, fracBits); !exact {
+			rounded++
+		}
+	}
+	return rounded
+}
+
+// ToFixed64 converts the posit to an int64 fixed point number with fracBits
+// fraction bits rounding in the given mode, if the result is out of range it saturates
+// to the minimum or maximum and ok is false. NaR returns 0 and false.
+func (p Posit16) ToFixed64(fracBits uint, mode RoundingMode) (int64, bool) {
+	x, ok := p.impl.toFixed(mode, fracBits, 64)
+	return int64(x), ok
+}
+
+// FromFixed64 creates a new posit which is set to the value of the fixed point number
+// v with fracBits fraction bits rounded to nearest even, the result is true if the
+// number could be converted without rounding.
+// p.FromFixed64() outputs a new posit z, p is not altered
+func (p Posit16) FromFixed64(v int64, fracBits uint) (Posit16, bool) {
+	x, exact := p.impl.FromFixed(int64(v), fracBits)
+	return Posit16{impl: x}, exact
+}
+
+// Posit16SliceToFixed64 converts every posit in src with ToFixed64 and stores
+// the results in dst, it returns the number of values which saturated or were NaR.
+// if dst and src are not the same length, this function will panic
+func Posit16SliceToFixed64(dst []int64, src []Posit16, fracBits uint, mode RoundingMode) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	bad := 0
+	for i, p := range src {
+		var ok bool
+		if dst[i], ok = p.ToFixed64(fracBits, mode); !ok {
+			bad++
+		}
+	}
+	return bad
+}
+
+// Posit16SliceFromFixed64 converts every fixed point number in src with
+// FromFixed64 and stores the results in dst, it returns the number of values which were rounded.
+// if dst and src are not the same length, this function will panic
+func Posit16SliceFromFixed64(dst []Posit16, src []int64, fracBits uint) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	p := NewPosit16()
+	rounded := 0
+	for i, v := range src {
+		var exact bool
+		if dst[i], exact = p.FromFixed64(v, fracBits); !exact {
+			rounded++
+		}
+	}
+	return rounded
+}
+
+// FromBigInt creates a new posit which is set to the value of i rounded to nearest even,
+// numbers larger than maxpos saturate to maxpos.
+// p.FromBigInt() outputs a new posit z, p is not altered
+func (p Posit16) FromBigInt(i *big.Int) Posit16 { return Posit16{impl: p.impl.FromBigInt(i)} }
+
+// FromRat creates a new posit which is set to the value of r rounded to nearest even,
+// numbers larger than maxpos saturate to maxpos and numbers which are not zero but
+// smaller than minpos become minpos.
+// p.FromRat() outputs a new posit z, p is not altered
+func (p Posit16) FromRat(r *big.Rat) Posit16 { return Posit16{impl: p.impl.FromRat(r)} }
+
+// FromBigFloat creates a new posit which is set to the value of f rounded to nearest
+// even, with the same saturation as FromRat, an infinite f becomes NaR.
+// p.FromBigFloat() outputs a new posit z, p is not altered
+func (p Posit16) FromBigFloat(f *big.Float) Posit16 { return Posit16{impl: p.impl.FromBigFloat(f)} }
+
+// BigInt returns the value of the posit rounded to the nearest integer, ties to even,
+// or nil if the posit is NaR. Unlike Int it never saturates.
+func (p Posit16) BigInt() *big.Int { return p.impl.BigInt() }
+
+// Rat returns the exact value of the posit, or nil if it is NaR
+func (p Posit16) Rat() *big.Rat { return p.impl.Rat() }
+
+// BigFloat returns the exact value of the posit, or nil if it is NaR
+func (p Posit16) BigFloat() *big.Float { return p.impl.BigFloat() }
+
 // Exp outputs the exponent of the posit, specifically the number z for which
 // 0.5*2**z <= positValue < 1*2**z
 func (p Posit16) Exp() int16 {
@@ -279,13 +963,15 @@ func (p Posit16) Up() Posit32 {
 // Down returns the same number down-casted to the next smaller posit, see Up() for the
 // definition of larger and smaller.
 func (p Posit16) Down() Posit8 {
-	return Posit8{impl: p.impl.Up()}
+	return Posit8{impl: p.impl.Down()}
 }
 
 // Bits outputs a uint16 containing the raw binary format of the posit
 func (p Posit16) Bits() uint16 {
 	words := p.impl.Bits.Bits()
-	if len(words) == 1 {
+	if len(words) == 0 {
+		return 0
+	} else if len(words) == 1 {
 		return uint16(words[0])
 	} else if len(words) == 2 {
 		if bits.UintSize != 32 {
@@ -302,17 +988,174 @@ func (p Posit16) SetBits(bits uint16) Posit16 {
 	return Posit16{impl: &SlowPosit{es: p.impl.es, nbits: p.impl.nbits, Bits: bi}}
 }
 
+// NextUp returns the smallest posit which is greater than p, the next posit after
+// the largest posit is NaR and the next posit after NaR is also NaR.
+func (p Posit16) NextUp() Posit16 { return Posit16{impl: p.impl.NextUp()} }
+
+// NextDown returns the largest posit which is less than p, the next posit before
+// the most negative posit is NaR and the next posit before NaR is also NaR.
+func (p Posit16) NextDown() Posit16 { return Posit16{impl: p.impl.NextDown()} }
+
+// ULP returns the unit in the last place of p as a posit, see SlowPosit.ULPFloat
+func (p Posit16) ULP() Posit16 { return Posit16{impl: p.impl.ULP()} }
+
+// ULPFloat returns the unit in the last place of p exactly, see SlowPosit.ULPFloat
+func (p Posit16) ULPFloat() *big.Float { return p.impl.ULPFloat() }
+
+// Distance returns the number of steps from p to x, for example the distance between a
+// posit and p.NextUp() is 1. The distance to NaR is not defined and will panic.
+func (p Posit16) Distance(x Posit16) uint16 { return uint16(p.impl.Distance(x.impl).Uint64()) }
+
+// Decode breaks the posit down into its bit fields, see SlowFields
+func (p Posit16) Decode() PositFields { return p.impl.Decode().positFields() }
+
+// Encode creates a new posit from bit fields, rounding to nearest even, see SlowPosit.Encode
+// p.Encode() outputs a new posit z, p is not altered
+func (p Posit16) Encode(f PositFields) Posit16 { return Posit16{impl: p.impl.Encode(f.slowFields())} }
+
 // Clone makes a copy of a posit
 func (p Posit16) Clone() Posit16 {
 	return Posit16{impl: p.impl.Clone()}
 }
 
+// slow returns the SlowPosit behind p, the zero value of Posit16 is treated as zero so
+// that structures containing posits can be encoded without being initialized.
+func (p Posit16) slow() *SlowPosit {
+	if p.impl == nil {
+		return NewSlowPosit(16, 1)
+	}
+	return p.impl
+}
+
+// MarshalBinary outputs the bits of the posit in little endian order
+func (p Posit16) MarshalBinary() ([]byte, error) { return p.slow().bytes(binary.LittleEndian), nil }
+
+// MarshalBinaryOrder outputs the bits of the posit in the specified order
+func (p Posit16) MarshalBinaryOrder(order binary.ByteOrder) []byte { return p.slow().bytes(order) }
+
+// UnmarshalBinary sets the posit from bits in little endian order
+func (p *Posit16) UnmarshalBinary(data []byte) error {
+	return p.UnmarshalBinaryOrder(data, binary.LittleEndian)
+}
+
+// UnmarshalBinaryOrder sets the posit from bits in the specified order
+func (p *Posit16) UnmarshalBinaryOrder(data []byte, order binary.ByteOrder) error {
+	out := NewSlowPosit(16, 1)
+	if err := out.setBytes(data, order); err != nil {
+		return err
+	}
+	*p = Posit16{impl: out}
+	return nil
+}
+
+// MarshalText outputs the shortest decimal which converts back to the same posit
+func (p Posit16) MarshalText() ([]byte, error) { return []byte(p.slow().decimal()), nil }
+
+// UnmarshalText sets the posit from a decimal number rounded to nearest even, a hex
+// string of bits beginning with 0x, or NaR.
+func (p *Posit16) UnmarshalText(text []byte) error {
+	out := NewSlowPosit(16, 1)
+	if err := out.parse(string(text)); err != nil {
+		return err
+	}
+	*p = Posit16{impl: out}
+	return nil
+}
+
+// MarshalJSON outputs the posit as a string in the format DefaultJSONFormat
+func (p Posit16) MarshalJSON() ([]byte, error) { return p.slow().marshalJSON(DefaultJSONFormat) }
+
+// MarshalJSONFormat outputs the posit as a string in the format f
+func (p Posit16) MarshalJSONFormat(f JSONFormat) ([]byte, error) { return p.slow().marshalJSON(f) }
+
+// Posit16JSONHex is a Posit16 which MarshalJSON always writes in the JSONHex format,
+// whatever DefaultJSONFormat is, use it for struct fields which need a fixed format.
+type Posit16JSONHex struct{ Posit16 }
+
+// MarshalJSON outputs the posit as a string in the format JSONHex
+func (p Posit16JSONHex) MarshalJSON() ([]byte, error) { return p.slow().marshalJSON(JSONHex) }
+
+// Posit16JSONDecimal is a Posit16 which MarshalJSON always writes in the JSONDecimal
+// format, whatever DefaultJSONFormat is, use it for struct fields which need a fixed format.
+type Posit16JSONDecimal struct{ Posit16 }
+
+// MarshalJSON outputs the posit as a string in the format JSONDecimal
+func (p Posit16JSONDecimal) MarshalJSON() ([]byte, error) { return p.slow().marshalJSON(JSONDecimal) }
+
+// UnmarshalJSON sets the posit from a string in any JSONFormat or from a number
+func (p *Posit16) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	out := NewSlowPosit(16, 1)
+	if err := out.unmarshalJSON(data); err != nil {
+		return err
+	}
+	*p = Posit16{impl: out}
+	return nil
+}
+
+// Value implements driver.Valuer, the posit is stored in the format DefaultSQLFormat
+func (p Posit16) Value() (driver.Value, error) { return p.slow().sqlValue(DefaultSQLFormat) }
+
+// Scan implements sql.Scanner, it accepts an integer containing the bits of the posit
+// either signed or unsigned, a string in any format accepted by UnmarshalText, or a float.
+func (p *Posit16) Scan(src any) error {
+	out := NewSlowPosit(16, 1)
+	if err := out.scan(src); err != nil {
+		return err
+	}
+	*p = Posit16{impl: out}
+	return nil
+}
+
+// Posit16SQLBits is a Posit16 which Value always stores in the format SQLBits,
+// whatever DefaultSQLFormat is, Scan accepts every format.
+type Posit16SQLBits struct{ Posit16 }
+
+// Value implements driver.Valuer, the posit is stored in the format SQLBits
+func (p Posit16SQLBits) Value() (driver.Value, error) { return p.slow().sqlValue(SQLBits) }
+
+// Posit16SQLDecimal is a Posit16 which Value always stores in the format SQLDecimal,
+// whatever DefaultSQLFormat is, Scan accepts every format.
+type Posit16SQLDecimal struct{ Posit16 }
+
+// Value implements driver.Valuer, the posit is stored in the format SQLDecimal
+func (p Posit16SQLDecimal) Value() (driver.Value, error) { return p.slow().sqlValue(SQLDecimal) }
+
 // Posit32 is an 32 bit posit with 2 exponent bits
 type Posit32 struct{ impl *SlowPosit }
 
 // NewPosit32 makes a new posit with 32 bits and 2 es bits, the initial value is zero
 func NewPosit32() Posit32 { return Posit32{impl: NewSlowPosit(32, 2)} }
 
+// Posit32NaR returns NaR (Not a Real)
+func Posit32NaR() Posit32 { return Posit32{impl: NewSlowPosit(32, 2).NaR()} }
+
+// Posit32Zero returns zero
+func Posit32Zero() Posit32 { return Posit32{impl: NewSlowPosit(32, 2).Zero()} }
+
+// Posit32One returns one
+func Posit32One() Posit32 { return Posit32{impl: NewSlowPosit(32, 2).One()} }
+
+// Posit32MaxPos returns the largest positive posit
+func Posit32MaxPos() Posit32 { return Posit32{impl: NewSlowPosit(32, 2).Max()} }
+
+// Posit32MinPos returns the smallest positive posit
+func Posit32MinPos() Posit32 { return Posit32{impl: NewSlowPosit(32, 2).Min()} }
+
+// Posit32Pi returns pi correctly rounded
+func Posit32Pi() Posit32 { return Posit32{impl: NewSlowPosit(32, 2).Pi()} }
+
+// Posit32E returns e correctly rounded
+func Posit32E() Posit32 { return Posit32{impl: NewSlowPosit(32, 2).E()} }
+
+// Posit32Ln2 returns the natural log of 2 correctly rounded
+func Posit32Ln2() Posit32 { return Posit32{impl: NewSlowPosit(32, 2).Ln2()} }
+
+// Posit32Sqrt2 returns the square root of 2 correctly rounded
+func Posit32Sqrt2() Posit32 { return Posit32{impl: NewSlowPosit(32, 2).Sqrt2()} }
+
 // Add takes the sum of two posits
 // p.Add(x) creates a new posit z which is p+x, neither p nor x are altered
 func (p Posit32) Add(x Posit32) Posit32 { return Posit32{impl: p.impl.Add(x.impl)} }
@@ -321,6 +1164,8 @@ func (p Posit32) Add(x Posit32) Posit32 { return Posit32{impl: p.impl.Add(x.impl
 // more posits, the first result is a posit which is the sum truncated
 // (round to negative infinity) and the second posit is the difference between
 // the first posit and the true sum. That is to say true_sum = out2 + out1.
+// In rare cases the difference needs more bits than the posit has, then it is
+// rounded to nearest even.
 func (p Posit32) AddExact(x Posit32) (Posit32, Posit32) {
 	res, diff := p.impl.AddExact(x.impl)
 	return Posit32{impl: res}, Posit32{impl: diff}
@@ -334,6 +1179,8 @@ func (p Posit32) Sub(x Posit32) Posit32 { return Posit32{impl: p.impl.Sub(x.impl
 // more posits, the first result is a posit which is the difference truncated
 // (round to negative infinity) and the second posit is the difference between
 // the first posit and the true sum. That is to say true_sum = out2 + out1.
+// In rare cases the difference needs more bits than the posit has, then it is
+// rounded to nearest even.
 func (p Posit32) SubExact(x Posit32) (Posit32, Posit32) {
 	res, diff := p.impl.SubExact(x.impl)
 	return Posit32{impl: res}, Posit32{impl: diff}
@@ -356,7 +1203,7 @@ func (p Posit32) Div(x Posit32) Posit32 { return Posit32{impl: p.impl.Div(x.impl
 // DivPromote takes the quotent of two posits
 // p.DivPromote(x) creates a new posit z which is p/x, as the next larger posit size
 // next larger means the bit width is doubled and the exponent size is increased by 1
-// this function is guaranteed not to round
+// the quotient is rounded to nearest even in the larger size
 func (p Posit32) DivPromote(x Posit32) Posit64 { return Posit64{impl: p.impl.DivPromote(x.impl)} }
 
 // Sqrt finds the square root of a posit
@@ -376,7 +1223,7 @@ func (p Posit32) FromUint(i uint32) Posit32 { return Posit32{impl: p.impl.FromUi
 // Int outputs an int32 representation of the value of the posit
 // lost bits are rounded to nearest even and if the number is greater than or equal
 // to two to the 32 -1 power, the maximum 0x7fffffff for positive input or
-// 0xffffffff for negative input will return.
+// -0x7fffffff for negative input will return.
 // Likewise if the number is less than or equal to 1/2 it will round down to zero.
 func (p Posit32) Int() int32 {
 	x := p.impl.Int()
@@ -391,17 +1238,261 @@ func (p Posit32) Int() int32 {
 
 // Uint outputs a uint32 representation of the value of the posit, if the posit
 // is less than or equal to 1/2, 0 will be returned and if the value is greater
-// than two to the maxBits power, 0xffffffffffffffff will be returned.
+// than 0xffffffff, 0xffffffff will be returned. Negative numbers return 0 and NaR returns the maximum.
 func (p Posit32) Uint() uint32 {
-	x := p.impl.Int()
-
+	x := p.impl.Uint()
 	if x > 0xffffffff {
 		return 0xffffffff
 	}
-
 	return uint32(x)
 }
 
+// ToInt converts the posit to an int32 rounding in the given mode, if the result is out
+// of range it saturates to the minimum or maximum int32 and ok is false.
+// NaR returns 0 and false.
+func (p Posit32) ToInt(mode RoundingMode) (int32, bool) {
+	x, ok := p.impl.toInt(mode, 32)
+	return int32(x), ok
+}
+
+// ToUint converts the posit to a uint32 rounding in the given mode, if the result is
+// negative or greater than 0xffffffff it saturates to 0 or 0xffffffff and ok is false.
+// NaR returns 0 and false.
+func (p Posit32) ToUint(mode RoundingMode) (uint32, bool) {
+	x, ok := p.impl.toUint(mode, 32)
+	return uint32(x), ok
+}
+
+// ToInt8 converts the posit to an int8 like ToInt
+func (p Posit32) ToInt8(mode RoundingMode) (int8, bool) {
+	x, ok := p.impl.toInt(mode, 8)
+	return int8(x), ok
+}
+
+// ToUint8 converts the posit to a uint8 like ToUint
+func (p Posit32) ToUint8(mode RoundingMode) (uint8, bool) {
+	x, ok := p.impl.toUint(mode, 8)
+	return uint8(x), ok
+}
+
+// ToInt16 converts the posit to an int16 like ToInt
+func (p Posit32) ToInt16(mode RoundingMode) (int16, bool) {
+	x, ok := p.impl.toInt(mode, 16)
+	return int16(x), ok
+}
+
+// ToUint16 converts the posit to a uint16 like ToUint
+func (p Posit32) ToUint16(mode RoundingMode) (uint16, bool) {
+	x, ok := p.impl.toUint(mode, 16)
+	return uint16(x), ok
+}
+
+// ToInt32 converts the posit to an int32 like ToInt
+func (p Posit32) ToInt32(mode RoundingMode) (int32, bool) {
+	x, ok := p.impl.toInt(mode, 32)
+	return int32(x), ok
+}
+
+// ToUint32 converts the posit to a uint32 like ToUint
+func (p Posit32) ToUint32(mode RoundingMode) (uint32, bool) {
+	x, ok := p.impl.toUint(mode, 32)
+	return uint32(x), ok
+}
+
+// ToInt64 converts the posit to an int64 like ToInt
+func (p Posit32) ToInt64(mode RoundingMode) (int64, bool) {
+	x, ok := p.impl.toInt(mode, 64)
+	return int64(x), ok
+}
+
+// ToUint64 converts the posit to a uint64 like ToUint
+func (p Posit32) ToUint64(mode RoundingMode) (uint64, bool) {
+	x, ok := p.impl.toUint(mode, 64)
+	return uint64(x), ok
+}
+
+// ToFixed16 converts the posit to an int16 fixed point number with fracBits
+// fraction bits rounding in the given mode, if the result is out of range it saturates
+// to the minimum or maximum and ok is false. NaR returns 0 and false.
+func (p Posit32) ToFixed16(fracBits uint, mode RoundingMode) (int16, bool) {
+	x, ok := p.impl.toFixed(mode, fracBits, 16)
+	return int16(x), ok
+}
+
+// FromFixed16 creates a new posit which is set to the value of the fixed point number
+// v with fracBits fraction bits rounded to nearest even, the result is true if the
+// number could be converted without rounding.
+// p.FromFixed16() outputs a new posit z, p is not altered
+func (p Posit32) FromFixed16(v int16, fracBits uint) (Posit32, bool) {
+	x, exact := p.impl.FromFixed(int64(v), fracBits)
+	return Posit32{impl: x}, exact
+}
+
+// Posit32SliceToFixed16 converts every posit in src with ToFixed16 and stores
+// the results in dst, it returns the number of values which saturated or were NaR.
+// if dst and src are not the same length, this function will panic
+func Posit32SliceToFixed16(dst []int16, src []Posit32, fracBits uint, mode RoundingMode) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	bad := 0
+	for i, p := range src {
+		var ok bool
+		if dst[i], ok = p.ToFixed16(fracBits, mode); !ok {
+			bad++
+		}
+	}
+	return bad
+}
+
+// Posit32SliceFromFixed16 converts every fixed point number in src with
+// FromFixed16 and stores the results in dst, it returns the number of values which were rounded.
+// if dst and src are not the same length, this function will panic
+func Posit32SliceFromFixed16(dst []Posit32, src []int16, fracBits uint) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	p := NewPosit32()
+	rounded := 0
+	for i, v := range src {
+		var exact bool
+		if dst[i], exact = p.FromFixed16(v, fracBits); !exact {
+			rounded++
+		}
+	}
+	return rounded
+}
+
+// ToFixed32 converts the posit to an int32 fixed point number with fracBits
+// fraction bits rounding in the given mode, if the result is out of range it saturates
+// to the minimum or maximum and ok is false. NaR returns 0 and false.
+func (p Posit32) ToFixed32(fracBits uint, mode RoundingMode) (int32, bool) {
+	x, ok := p.impl.toFixed(mode, fracBits, 32)
+	return int32(x), ok
+}
+
+// FromFixed32 creates a new posit which is set to the value of the fixed point number
+// v with fracBits fraction bits rounded to nearest even, the result is true if the
+// number could be converted without rounding.
+// p.FromFixed32() outputs a new posit z, p is not altered
+func (p Posit32) FromFixed32(v int32, fracBits uint) (Posit32, bool) {
+	x, exact := p.impl.FromFixed(int64(v), fracBits)
+	return Posit32{impl: x}, exact
+}
+
+// Posit32SliceToFixed32 converts every posit in src with ToFixed32 and stores
+// the results in dst, it returns the number of values which saturated or were NaR.
+// if dst and src are not the same length, this function will panic
+func Posit32SliceToFixed32(dst []int32, src []Posit32, fracBits uint, mode RoundingMode) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	bad := 0
+	for i, p := range src {
+		var ok bool
+		if dst[i], ok = p.ToFixed32(fracBits, mode); !ok {
+			bad++
+		}
+	}
+	return bad
+}
+
+// Posit32SliceFromFixed32 converts every fixed point number in src with
+// FromFixed32 and stores the results in dst, it returns the number of values which were rounded.
+// if dst and src are not the same length, this function will panic
+func Posit32SliceFromFixed32(dst []Posit32, src []int32, fracBits uint) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	p := NewPosit32()
+	rounded := 0
+	for i, v := range src {
+		var exact bool
+		if dst[i], exact = p.FromFixed32(v, fracBits); !exact {
+			rounded++
+		}
+	}
+	return rounded
+}
+
+// ToFixed64 converts the posit to an int64 fixed point number with fracBits
+// fraction bits rounding in the given mode, if the result is out of range it saturates
+// to the minimum or maximum and ok is false. NaR returns 0 and false.
+func (p Posit32) ToFixed64(fracBits uint, mode RoundingMode) (int64, bool) {
+	x, ok := p.impl.toFixed(mode, fracBits, 64)
+	return int64(x), ok
+}
+
+// FromFixed64 creates a new posit which is set to the value of the fixed point number
+// v with fracBits fraction bits rounded to nearest even, the result is true if the
+// number could be converted without rounding.
+// p.FromFixed64() outputs a new posit z, p is not altered
+func (p Posit32) FromFixed64(v int64, fracBits uint) (Posit32, bool) {
+	x, exact := p.impl.FromFixed(int64(v), fracBits)
+	return Posit32{impl: x}, exact
+}
+
+// Posit32SliceToFixed64 converts every posit in src with ToFixed64 and stores
+// the results in dst, it returns the number of values which saturated or were NaR.
+// if dst and src are not the same length, this function will panic
+func Posit32SliceToFixed64(dst []int64, src []Posit32, fracBits uint, mode RoundingMode) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	bad := 0
+	for i, p := range src {
+		var ok bool
+		if dst[i], ok = p.ToFixed64(fracBits, mode); !ok {
+			bad++
+		}
+	}
+	return bad
+}
+
+// Posit32SliceFromFixed64 converts every fixed point number in src with
+// FromFixed64 and stores the results in dst, it returns the number of values which were rounded.
+// if dst and src are not the same length, this function will panic
+func Posit32SliceFromFixed64(dst []Posit32, src []int64, fracBits uint) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	p := NewPosit32()
+	rounded := 0
+	for i, v := range src {
+		var exact bool
+		if dst[i], exact = p.FromFixed64(v, fracBits); !exact {
+			rounded++
+		}
+	}
+	return rounded
+}
+
+// FromBigInt creates a new posit which is set to the value of i rounded to nearest even,
+// numbers larger than maxpos saturate to maxpos.
+// p.FromBigInt() outputs a new posit z, p is not altered
+func (p Posit32) FromBigInt(i *big.Int) Posit32 { return Posit32{impl: p.impl.FromBigInt(i)} }
+
+// FromRat creates a new posit which is set to the value of r rounded to nearest even,
+// numbers larger than maxpos saturate to maxpos and numbers which are not zero but
+// smaller than minpos become minpos.
+// p.FromRat() outputs a new posit z, p is not altered
+func (p Posit32) FromRat(r *big.Rat) Posit32 { return Posit32{impl: p.impl.FromRat(r)} }
+
+// FromBigFloat creates a new posit which is set to the value of f rounded to nearest
+// even, with the same saturation as FromRat, an infinite f becomes NaR.
+// p.FromBigFloat() outputs a new posit z, p is not altered
+func (p Posit32) FromBigFloat(f *big.Float) Posit32 { return Posit32{impl: p.impl.FromBigFloat(f)} }
+
+// BigInt returns the value of the posit rounded to the nearest integer, ties to even,
+// or nil if the posit is NaR. Unlike Int it never saturates.
+func (p Posit32) BigInt() *big.Int { return p.impl.BigInt() }
+
+// Rat returns the exact value of the posit, or nil if it is NaR
+func (p Posit32) Rat() *big.Rat { return p.impl.Rat() }
+
+// BigFloat returns the exact value of the posit, or nil if it is NaR
+func (p Posit32) BigFloat() *big.Float { return p.impl.BigFloat() }
+
 // Exp outputs the exponent of the posit, specifically the number z for which
 // 0.5*2**z <= positValue < 1*2**z
 func (p Posit32) Exp() int32 {
@@ -433,13 +1524,15 @@ func (p Posit32) Up() Posit64 {
 // Down returns the same number down-casted to the next smaller posit, see Up() for the
 // definition of larger and smaller.
 func (p Posit32) Down() Posit16 {
-	return Posit16{impl: p.impl.Up()}
+	return Posit16{impl: p.impl.Down()}
 }
 
 // Bits outputs a uint32 containing the raw binary format of the posit
 func (p Posit32) Bits() uint32 {
 	words := p.impl.Bits.Bits()
-	if len(words) == 1 {
+	if len(words) == 0 {
+		return 0
+	} else if len(words) == 1 {
 		return uint32(words[0])
 	} else if len(words) == 2 {
 		if bits.UintSize != 32 {
@@ -456,17 +1549,174 @@ func (p Posit32) SetBits(bits uint32) Posit32 {
 	return Posit32{impl: &SlowPosit{es: p.impl.es, nbits: p.impl.nbits, Bits: bi}}
 }
 
+// NextUp returns the smallest posit which is greater than p, the next posit after
+// the largest posit is NaR and the next posit after NaR is also NaR.
+func (p Posit32) NextUp() Posit32 { return Posit32{impl: p.impl.NextUp()} }
+
+// NextDown returns the largest posit which is less than p, the next posit before
+// the most negative posit is NaR and the next posit before NaR is also NaR.
+func (p Posit32) NextDown() Posit32 { return Posit32{impl: p.impl.NextDown()} }
+
+// ULP returns the unit in the last place of p as a posit, see SlowPosit.ULPFloat
+func (p Posit32) ULP() Posit32 { return Posit32{impl: p.impl.ULP()} }
+
+// ULPFloat returns the unit in the last place of p exactly, see SlowPosit.ULPFloat
+func (p Posit32) ULPFloat() *big.Float { return p.impl.ULPFloat() }
+
+// Distance returns the number of steps from p to x, for example the distance between a
+// posit and p.NextUp() is 1. The distance to NaR is not defined and will panic.
+func (p Posit32) Distance(x Posit32) uint32 { return uint32(p.impl.Distance(x.impl).Uint64()) }
+
+// Decode breaks the posit down into its bit fields, see SlowFields
+func (p Posit32) Decode() PositFields { return p.impl.Decode().positFields() }
+
+// Encode creates a new posit from bit fields, rounding to nearest even, see SlowPosit.Encode
+// p.Encode() outputs a new posit z, p is not altered
+func (p Posit32) Encode(f PositFields) Posit32 { return Posit32{impl: p.impl.Encode(f.slowFields())} }
+
 // Clone makes a copy of a posit
 func (p Posit32) Clone() Posit32 {
 	return Posit32{impl: p.impl.Clone()}
 }
 
+// slow returns the SlowPosit behind p, the zero value of Posit32 is treated as zero so
+// that structures containing posits can be encoded without being initialized.
+func (p Posit32) slow() *SlowPosit {
+	if p.impl == nil {
+		return NewSlowPosit(32, 2)
+	}
+	return p.impl
+}
+
+// MarshalBinary outputs the bits of the posit in little endian order
+func (p Posit32) MarshalBinary() ([]byte, error) { return p.slow().bytes(binary.LittleEndian), nil }
+
+// MarshalBinaryOrder outputs the bits of the posit in the specified order
+func (p Posit32) MarshalBinaryOrder(order binary.ByteOrder) []byte { return p.slow().bytes(order) }
+
+// UnmarshalBinary sets the posit from bits in little endian order
+func (p *Posit32) UnmarshalBinary(data []byte) error {
+	return p.UnmarshalBinaryOrder(data, binary.LittleEndian)
+}
+
+// UnmarshalBinaryOrder sets the posit from bits in the specified order
+func (p *Posit32) UnmarshalBinaryOrder(data []byte, order binary.ByteOrder) error {
+	out := NewSlowPosit(32, 2)
+	if err := out.setBytes(data, order); err != nil {
+		return err
+	}
+	*p = Posit32{impl: out}
+	return nil
+}
+
+// MarshalText outputs the shortest decimal which converts back to the same posit
+func (p Posit32) MarshalText() ([]byte, error) { return []byte(p.slow().decimal()), nil }
+
+// UnmarshalText sets the posit from a decimal number rounded to nearest even, a hex
+// string of bits beginning with 0x, or NaR.
+func (p *Posit32) UnmarshalText(text []byte) error {
+	out := NewSlowPosit(32, 2)
+	if err := out.parse(string(text)); err != nil {
+		return err
+	}
+	*p = Posit32{impl: out}
+	return nil
+}
+
+// MarshalJSON outputs the posit as a string in the format DefaultJSONFormat
+func (p Posit32) MarshalJSON() ([]byte, error) { return p.slow().marshalJSON(DefaultJSONFormat) }
+
+// MarshalJSONFormat outputs the posit as a string in the format f
+func (p Posit32) MarshalJSONFormat(f JSONFormat) ([]byte, error) { return p.slow().marshalJSON(f) }
+
+// Posit32JSONHex is a Posit32 which MarshalJSON always writes in the JSONHex format,
+// whatever DefaultJSONFormat is, use it for struct fields which need a fixed format.
+type Posit32JSONHex struct{ Posit32 }
+
+// MarshalJSON outputs the posit as a string in the format JSONHex
+func (p Posit32JSONHex) MarshalJSON() ([]byte, error) { return p.slow().marshalJSON(JSONHex) }
+
+// Posit32JSONDecimal is a Posit32 which MarshalJSON always writes in the JSONDecimal
+// format, whatever DefaultJSONFormat is, use it for struct fields which need a fixed format.
+type Posit32JSONDecimal struct{ Posit32 }
+
+// MarshalJSON outputs the posit as a string in the format JSONDecimal
+func (p Posit32JSONDecimal) MarshalJSON() ([]byte, error) { return p.slow().marshalJSON(JSONDecimal) }
+
+// UnmarshalJSON sets the posit from a string in any JSONFormat or from a number
+func (p *Posit32) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	out := NewSlowPosit(32, 2)
+	if err := out.unmarshalJSON(data); err != nil {
+		return err
+	}
+	*p = Posit32{impl: out}
+	return nil
+}
+
+// Value implements driver.Valuer, the posit is stored in the format DefaultSQLFormat
+func (p Posit32) Value() (driver.Value, error) { return p.slow().sqlValue(DefaultSQLFormat) }
+
+// Scan implements sql.Scanner, it accepts an integer containing the bits of the posit
+// either signed or unsigned, a string in any format accepted by UnmarshalText, or a float.
+func (p *Posit32) Scan(src any) error {
+	out := NewSlowPosit(32, 2)
+	if err := out.scan(src); err != nil {
+		return err
+	}
+	*p = Posit32{impl: out}
+	return nil
+}
+
+// Posit32SQLBits is a Posit32 which Value always stores in the format SQLBits,
+// whatever DefaultSQLFormat is, Scan accepts every format.
+type Posit32SQLBits struct{ Posit32 }
+
+// Value implements driver.Valuer, the posit is stored in the format SQLBits
+func (p Posit32SQLBits) Value() (driver.Value, error) { return p.slow().sqlValue(SQLBits) }
+
+// Posit32SQLDecimal is a Posit32 which Value always stores in the format SQLDecimal,
+// whatever DefaultSQLFormat is, Scan accepts every format.
+type Posit32SQLDecimal struct{ Posit32 }
+
+// Value implements driver.Valuer, the posit is stored in the format SQLDecimal
+func (p Posit32SQLDecimal) Value() (driver.Value, error) { return p.slow().sqlValue(SQLDecimal) }
+
 // Posit64 is an 64 bit posit with 3 exponent bits
 type Posit64 struct{ impl *SlowPosit }
 
 // NewPosit64 makes a new posit with 64 bits and 3 es bits, the initial value is zero
 func NewPosit64() Posit64 { return Posit64{impl: NewSlowPosit(64, 3)} }
 
+// Posit64NaR returns NaR (Not a Real)
+func Posit64NaR() Posit64 { return Posit64{impl: NewSlowPosit(64, 3).NaR()} }
+
+// Posit64Zero returns zero
+func Posit64Zero() Posit64 { return Posit64{impl: NewSlowPosit(64, 3).Zero()} }
+
+// Posit64One returns one
+func Posit64One() Posit64 { return Posit64{impl: NewSlowPosit(64, 3).One()} }
+
+// Posit64MaxPos returns the largest positive posit
+func Posit64MaxPos() Posit64 { return Posit64{impl: NewSlowPosit(64, 3).Max()} }
+
+// Posit64MinPos returns the smallest positive posit
+func Posit64MinPos() Posit64 { return Posit64{impl: NewSlowPosit(64, 3).Min()} }
+
+// Posit64Pi returns pi correctly rounded
+func Posit64Pi() Posit64 { return Posit64{impl: NewSlowPosit(64, 3).Pi()} }
+
+// Posit64E returns e correctly rounded
+func Posit64E() Posit64 { return Posit64{impl: NewSlowPosit(64, 3).E()} }
+
+// Posit64Ln2 returns the natural log of 2 correctly rounded
+func Posit64Ln2() Posit64 { return Posit64{impl: NewSlowPosit(64, 3).Ln2()} }
+
+// Posit64Sqrt2 returns the square root of 2 correctly rounded
+func Posit64Sqrt2() Posit64 { return Posit64{impl: NewSlowPosit(64, 3).Sqrt2()} }
+
 // Add takes the sum of two posits
 // p.Add(x) creates a new posit z which is p+x, neither p nor x are altered
 func (p Posit64) Add(x Posit64) Posit64 { return Posit64{impl: p.impl.Add(x.impl)} }
@@ -475,6 +1725,8 @@ func (p Posit64) Add(x Posit64) Posit64 { return Posit64{impl: p.impl.Add(x.impl
 // more posits, the first result is a posit which is the sum truncated
 // (round to negative infinity) and the second posit is the difference between
 // the first posit and the true sum. That is to say true_sum = out2 + out1.
+// In rare cases the difference needs more bits than the posit has, then it is
+// rounded to nearest even.
 func (p Posit64) AddExact(x Posit64) (Posit64, Posit64) {
 	res, diff := p.impl.AddExact(x.impl)
 	return Posit64{impl: res}, Posit64{impl: diff}
@@ -488,6 +1740,8 @@ func (p Posit64) Sub(x Posit64) Posit64 { return Posit64{impl: p.impl.Sub(x.impl
 // more posits, the first result is a posit which is the difference truncated
 // (round to negative infinity) and the second posit is the difference between
 // the first posit and the true sum. That is to say true_sum = out2 + out1.
+// In rare cases the difference needs more bits than the posit has, then it is
+// rounded to nearest even.
 func (p Posit64) SubExact(x Posit64) (Posit64, Posit64) {
 	res, diff := p.impl.SubExact(x.impl)
 	return Posit64{impl: res}, Posit64{impl: diff}
@@ -510,7 +1764,7 @@ func (p Posit64) Div(x Posit64) Posit64 { return Posit64{impl: p.impl.Div(x.impl
 // DivPromote takes the quotent of two posits
 // p.DivPromote(x) creates a new posit z which is p/x, as the next larger posit size
 // next larger means the bit width is doubled and the exponent size is increased by 1
-// this function is guaranteed not to round
+// the quotient is rounded to nearest even in the larger size
 func (p Posit64) DivPromote(x Posit64) Posit128 { return Posit128{impl: p.impl.DivPromote(x.impl)} }
 
 // Sqrt finds the square root of a posit
@@ -530,7 +1784,7 @@ func (p Posit64) FromUint(i uint64) Posit64 { return Posit64{impl: p.impl.FromUi
 // Int outputs an int64 representation of the value of the posit
 // lost bits are rounded to nearest even and if the number is greater than or equal
 // to two to the 64 -1 power, the maximum 0x7fffffffffffffff for positive input or
-// 0xffffffffffffffff for negative input will return.
+// -0x7fffffffffffffff for negative input will return.
 // Likewise if the number is less than or equal to 1/2 it will round down to zero.
 func (p Posit64) Int() int64 {
 	x := p.impl.Int()
@@ -545,13 +1799,258 @@ func (p Posit64) Int() int64 {
 
 // Uint outputs a uint64 representation of the value of the posit, if the posit
 // is less than or equal to 1/2, 0 will be returned and if the value is greater
-// than two to the maxBits power, 0xffffffffffffffff will be returned.
+// than 0xffffffffffffffff, 0xffffffffffffffff will be returned. Negative numbers return 0 and NaR returns the maximum.
 func (p Posit64) Uint() uint64 {
-	x := p.impl.Int()
-
+	x := p.impl.Uint()
 	return uint64(x)
 }
 
+// ToInt converts the posit to an int64 rounding in the given mode, if the result is out
+// of range it saturates to the minimum or maximum int64 and ok is false.
+// NaR returns 0 and false.
+func (p Posit64) ToInt(mode RoundingMode) (int64, bool) {
+	x, ok := p.impl.toInt(mode, 64)
+	return int64(x), ok
+}
+
+// ToUint converts the posit to a uint64 rounding in the given mode, if the result is
+// negative or greater than 0xffffffffffffffff it saturates to 0 or 0xffffffffffffffff and ok is false.
+// NaR returns 0 and false.
+func (p Posit64) ToUint(mode RoundingMode) (uint64, bool) {
+	x, ok := p.impl.toUint(mode, 64)
+	return uint64(x), ok
+}
+
+// ToInt8 converts the posit to an int8 like ToInt
+func (p Posit64) ToInt8(mode RoundingMode) (int8, bool) {
+	x, ok := p.impl.toInt(mode, 8)
+	return int8(x), ok
+}
+
+// ToUint8 converts the posit to a uint8 like ToUint
+func (p Posit64) ToUint8(mode RoundingMode) (uint8, bool) {
+	x, ok := p.impl.toUint(mode, 8)
+	return uint8(x), ok
+}
+
+// ToInt16 converts the posit to an int16 like ToInt
+func (p Posit64) ToInt16(mode RoundingMode) (int16, bool) {
+	x, ok := p.impl.toInt(mode, 16)
+	return int16(x), ok
+}
+
+// ToUint16 converts the posit to a uint16 like ToUint
+func (p Posit64) ToUint16(mode RoundingMode) (uint16, bool) {
+	x, ok := p.impl.toUint(mode, 16)
+	return uint16(x), ok
+}
+
+// ToInt32 converts the posit to an int32 like ToInt
+func (p Posit64) ToInt32(mode RoundingMode) (int32, bool) {
+	x, ok := p.impl.toInt(mode, 32)
+	return int32(x), ok
+}
+
+// ToUint32 converts the posit to a uint32 like ToUint
+func (p Posit64) ToUint32(mode RoundingMode) (uint32, bool) {
+	x, ok := p.impl.toUint(mode, 32)
+	return uint32(x), ok
+}
+
+// ToInt64 converts the posit to an int64 like ToInt
+func (p Posit64) ToInt64(mode RoundingMode) (int64, bool) {
+	x, ok := p.impl.toInt(mode, 64)
+	return int64(x), ok
+}
+
+// ToUint64 converts the posit to a uint64 like ToUint
+func (p Posit64) ToUint64(mode RoundingMode) (uint64, bool) {
+	x, ok := p.impl.toUint(mode, 64)
+	return uint64(x), ok
+}
+
+// ToFixed16 converts the posit to an int16 fixed point number with fracBits
+// fraction bits rounding in the given mode, if the result is out of range it saturates
+// to the minimum or maximum and ok is false. NaR returns 0 and false.
+func (p Posit64) ToFixed16(fracBits uint, mode RoundingMode) (int16, bool) {
+	x, ok := p.impl.toFixed(mode, fracBits, 16)
+	return int16(x), ok
+}
+
+// FromFixed16 creates a new posit which is set to the value of the fixed point number
+// v with fracBits fraction bits rounded to nearest even, the result is true if the
+// number could be converted without rounding.
+// p.FromFixed16() outputs a new posit z, p is not altered
+func (p Posit64) FromFixed16(v int16, fracBits uint) (Posit64, bool) {
+	x, exact := p.impl.FromFixed(int64(v), fracBits)
+	return Posit64{impl: x}, exact
+}
+
+// Posit64SliceToFixed16 converts every posit in src with ToFixed16 and stores
+// the results in dst, it returns the number of values which saturated or were NaR.
+// if dst and src are not the same length, this function will panic
+func Posit64SliceToFixed16(dst []int16, src []Posit64, fracBits uint, mode RoundingMode) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	bad := 0
+	for i, p := range src {
+		var ok bool
+		if dst[i], ok = p.ToFixed16(fracBits, mode); !ok {
+			bad++
+		}
+	}
+	return bad
+}
+
+// Posit64SliceFromFixed16 converts every fixed point number in src with
+// FromFixed16 and stores the results in dst, it returns the number of values which were rounded.
+// if dst and src are not the same length, this function will panic
+func Posit64SliceFromFixed16(dst []Posit64, src []int16, fracBits uint) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	p := NewPosit64()
+	rounded := 0
+	for i, v := range src {
+		var exact bool
+		if dst[i], exact = p.FromFixed16(v, fracBits); !exact {
+			rounded++
+		}
+	}
+	return rounded
+}
+
+// ToFixed32 converts the posit to an int32 fixed point number with fracBits
+// fraction bits rounding in the given mode, if the result is out of range it saturates
+// to the minimum or maximum and ok is false. NaR returns 0 and false.
+func (p Posit64) ToFixed32(fracBits uint, mode RoundingMode) (int32, bool) {
+	x, ok := p.impl.toFixed(mode, fracBits, 32)
+	return int32(x), ok
+}
+
+// FromFixed32 creates a new posit which is set to the value of the fixed point number
+// v with fracBits fraction bits rounded to nearest even, the result is true if the
+// number could be converted without rounding.
+// p.FromFixed32() outputs a new posit z, p is not altered
+func (p Posit64) FromFixed32(v int32, fracBits uint) (Posit64, bool) {
+	x, exact := p.impl.FromFixed(int64(v), fracBits)
+	return Posit64{impl: x}, exact
+}
+
+// Posit64SliceToFixed32 converts every posit in src with ToFixed32 and stores
+// the results in dst, it returns the number of values which saturated or were NaR.
+// if dst and src are not the same length, this function will panic
+func Posit64SliceToFixed32(dst []int32, src []Posit64, fracBits uint, mode RoundingMode) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	bad := 0
+	for i, p := range src {
+		var ok bool
+		if dst[i], ok = p.ToFixed32(fracBits, mode); !ok {
+			bad++
+		}
+	}
+	return bad
+}
+
+// Posit64SliceFromFixed32 converts every fixed point number in src with
+// FromFixed32 and stores the results in dst, it returns the number of values which were rounded.
+// if dst and src are not the same length, this function will panic
+func Posit64SliceFromFixed32(dst []Posit64, src []int32, fracBits uint) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	p := NewPosit64()
+	rounded := 0
+	for i, v := range src {
+		var exact bool
+		if dst[i], exact = p.FromFixed32(v, fracBits); !exact {
+			rounded++
+		}
+	}
+	return rounded
+}
+
+// ToFixed64 converts the posit to an int64 fixed point number with fracBits
+// fraction bits rounding in the given mode, if the result is out of range it saturates
+// to the minimum or maximum and ok is false. NaR returns 0 and false.
+func (p Posit64) ToFixed64(fracBits uint, mode RoundingMode) (int64, bool) {
+	x, ok := p.impl.toFixed(mode, fracBits, 64)
+	return int64(x), ok
+}
+
+// FromFixed64 creates a new posit which is set to the value of the fixed point number
+// v with fracBits fraction bits rounded to nearest even, the result is true if the
+// number could be converted without rounding.
+// p.FromFixed64() outputs a new posit z, p is not altered
+func (p Posit64) FromFixed64(v int64, fracBits uint) (Posit64, bool) {
+	x, exact := p.impl.FromFixed(int64(v), fracBits)
+	return Posit64{impl: x}, exact
+}
+
+// Posit64SliceToFixed64 converts every posit in src with ToFixed64 and stores
+// the results in dst, it returns the number of values which saturated or were NaR.
+// if dst and src are not the same length, this function will panic
+func Posit64SliceToFixed64(dst []int64, src []Posit64, fracBits uint, mode RoundingMode) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	bad := 0
+	for i, p := range src {
+		var ok bool
+		if dst[i], ok = p.ToFixed64(fracBits, mode); !ok {
+			bad++
+		}
+	}
+	return bad
+}
+
+// Posit64SliceFromFixed64 converts every fixed point number in src with
+// FromFixed64 and stores the results in dst, it returns the number of values which were rounded.
+// if dst and src are not the same length, this function will panic
+func Posit64SliceFromFixed64(dst []Posit64, src []int64, fracBits uint) int {
+	if len(dst) != len(src) {
+		panic("unexpected length of input")
+	}
+	p := NewPosit64()
+	rounded := 0
+	for i, v := range src {
+		var exact bool
+		if dst[i], exact = p.FromFixed64(v, fracBits); !exact {
+			rounded++
+		}
+	}
+	return rounded
+}
+
+// FromBigInt creates a new posit which is set to the value of i rounded to nearest even,
+// numbers larger than maxpos saturate to maxpos.
+// p.FromBigInt() outputs a new posit z, p is not altered
+func (p Posit64) FromBigInt(i *big.Int) Posit64 { return Posit64{impl: p.impl.FromBigInt(i)} }
+
+// FromRat creates a new posit which is set to the value of r rounded to nearest even,
+// numbers larger than maxpos saturate to maxpos and numbers which are not zero but
+// smaller than minpos become minpos.
+// p.FromRat() outputs a new posit z, p is not altered
+func (p Posit64) FromRat(r *big.Rat) Posit64 { return Posit64{impl: p.impl.FromRat(r)} }
+
+// FromBigFloat creates a new posit which is set to the value of f rounded to nearest
+// even, with the same saturation as FromRat, an infinite f becomes NaR.
+// p.FromBigFloat() outputs a new posit z, p is not altered
+func (p Posit64) FromBigFloat(f *big.Float) Posit64 { return Posit64{impl: p.impl.FromBigFloat(f)} }
+
+// BigInt returns the value of the posit rounded to the nearest integer, ties to even,
+// or nil if the posit is NaR. Unlike Int it never saturates.
+func (p Posit64) BigInt() *big.Int { return p.impl.BigInt() }
+
+// Rat returns the exact value of the posit, or nil if it is NaR
+func (p Posit64) Rat() *big.Rat { return p.impl.Rat() }
+
+// BigFloat returns the exact value of the posit, or nil if it is NaR
+func (p Posit64) BigFloat() *big.Float { return p.impl.BigFloat() }
+
 // Exp outputs the exponent of the posit, specifically the number z for which
 // 0.5*2**z <= positValue < 1*2**z
 func (p Posit64) Exp() int64 {
@@ -579,13 +2078,15 @@ func (p Posit64) Up() Posit128 {
 // Down returns the same number down-casted to the next smaller posit, see Up() for the
 // definition of larger and smaller.
 func (p Posit64) Down() Posit32 {
-	return Posit32{impl: p.impl.Up()}
+	return Posit32{impl: p.impl.Down()}
 }
 
 // Bits outputs a uint64 containing the raw binary format of the posit
 func (p Posit64) Bits() uint64 {
 	words := p.impl.Bits.Bits()
-	if len(words) == 1 {
+	if len(words) == 0 {
+		return 0
+	} else if len(words) == 1 {
 		return uint64(words[0])
 	} else if len(words) == 2 {
 		if bits.UintSize != 32 {
@@ -602,7 +2103,137 @@ func (p Posit64) SetBits(bits uint64) Posit64 {
 	return Posit64{impl: &SlowPosit{es: p.impl.es, nbits: p.impl.nbits, Bits: bi}}
 }
 
+// NextUp returns the smallest posit which is greater than p, the next posit after
+// the largest posit is NaR and the next posit after NaR is also NaR.
+func (p Posit64) NextUp() Posit64 { return Posit64{impl: p.impl.NextUp()} }
+
+// NextDown returns the largest posit which is less than p, the next posit before
+// the most negative posit is NaR and the next posit before NaR is also NaR.
+func (p Posit64) NextDown() Posit64 { return Posit64{impl: p.impl.NextDown()} }
+
+// ULP returns the unit in the last place of p as a posit, see SlowPosit.ULPFloat
+func (p Posit64) ULP() Posit64 { return Posit64{impl: p.impl.ULP()} }
+
+// ULPFloat returns the unit in the last place of p exactly, see SlowPosit.ULPFloat
+func (p Posit64) ULPFloat() *big.Float { return p.impl.ULPFloat() }
+
+// Distance returns the number of steps from p to x, for example the distance between a
+// posit and p.NextUp() is 1. The distance to NaR is not defined and will panic.
+func (p Posit64) Distance(x Posit64) uint64 { return uint64(p.impl.Distance(x.impl).Uint64()) }
+
+// Decode breaks the posit down into its bit fields, see SlowFields
+func (p Posit64) Decode() PositFields { return p.impl.Decode().positFields() }
+
+// Encode creates a new posit from bit fields, rounding to nearest even, see SlowPosit.Encode
+// p.Encode() outputs a new posit z, p is not altered
+func (p Posit64) Encode(f PositFields) Posit64 { return Posit64{impl: p.impl.Encode(f.slowFields())} }
+
 // Clone makes a copy of a posit
 func (p Posit64) Clone() Posit64 {
 	return Posit64{impl: p.impl.Clone()}
 }
+
+// slow returns the SlowPosit behind p, the zero value of Posit64 is treated as zero so
+// that structures containing posits can be encoded without being initialized.
+func (p Posit64) slow() *SlowPosit {
+	if p.impl == nil {
+		return NewSlowPosit(64, 3)
+	}
+	return p.impl
+}
+
+// MarshalBinary outputs the bits of the posit in little endian order
+func (p Posit64) MarshalBinary() ([]byte, error) { return p.slow().bytes(binary.LittleEndian), nil }
+
+// MarshalBinaryOrder outputs the bits of the posit in the specified order
+func (p Posit64) MarshalBinaryOrder(order binary.ByteOrder) []byte { return p.slow().bytes(order) }
+
+// UnmarshalBinary sets the posit from bits in little endian order
+func (p *Posit64) UnmarshalBinary(data []byte) error {
+	return p.UnmarshalBinaryOrder(data, binary.LittleEndian)
+}
+
+// UnmarshalBinaryOrder sets the posit from bits in the specified order
+func (p *Posit64) UnmarshalBinaryOrder(data []byte, order binary.ByteOrder) error {
+	out := NewSlowPosit(64, 3)
+	if err := out.setBytes(data, order); err != nil {
+		return err
+	}
+	*p = Posit64{impl: out}
+	return nil
+}
+
+// MarshalText outputs the shortest decimal which converts back to the same posit
+func (p Posit64) MarshalText() ([]byte, error) { return []byte(p.slow().decimal()), nil }
+
+// UnmarshalText sets the posit from a decimal number rounded to nearest even, a hex
+// string of bits beginning with 0x, or NaR.
+func (p *Posit64) UnmarshalText(text []byte) error {
+	out := NewSlowPosit(64, 3)
+	if err := out.parse(string(text)); err != nil {
+		return err
+	}
+	*p = Posit64{impl: out}
+	return nil
+}
+
+// MarshalJSON outputs the posit as a string in the format DefaultJSONFormat
+func (p Posit64) MarshalJSON() ([]byte, error) { return p.slow().marshalJSON(DefaultJSONFormat) }
+
+// MarshalJSONFormat outputs the posit as a string in the format f
+func (p Posit64) MarshalJSONFormat(f JSONFormat) ([]byte, error) { return p.slow().marshalJSON(f) }
+
+// Posit64JSONHex is a Posit64 which MarshalJSON always writes in the JSONHex format,
+// whatever DefaultJSONFormat is, use it for struct fields which need a fixed format.
+type Posit64JSONHex struct{ Posit64 }
+
+// MarshalJSON outputs the posit as a string in the format JSONHex
+func (p Posit64JSONHex) MarshalJSON() ([]byte, error) { return p.slow().marshalJSON(JSONHex) }
+
+// Posit64JSONDecimal is a Posit64 which MarshalJSON always writes in the JSONDecimal
+// format, whatever DefaultJSONFormat is, use it for struct fields which need a fixed format.
+type Posit64JSONDecimal struct{ Posit64 }
+
+// MarshalJSON outputs the posit as a string in the format JSONDecimal
+func (p Posit64JSONDecimal) MarshalJSON() ([]byte, error) { return p.slow().marshalJSON(JSONDecimal) }
+
+// UnmarshalJSON sets the posit from a string in any JSONFormat or from a number
+func (p *Posit64) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	out := NewSlowPosit(64, 3)
+	if err := out.unmarshalJSON(data); err != nil {
+		return err
+	}
+	*p = Posit64{impl: out}
+	return nil
+}
+
+// Value implements driver.Valuer, the posit is stored in the format DefaultSQLFormat
+func (p Posit64) Value() (driver.Value, error) { return p.slow().sqlValue(DefaultSQLFormat) }
+
+// Scan implements sql.Scanner, it accepts an integer containing the bits of the posit
+// either signed or unsigned, a string in any format accepted by UnmarshalText, or a float.
+func (p *Posit64) Scan(src any) error {
+	out := NewSlowPosit(64, 3)
+	if err := out.scan(src); err != nil {
+		return err
+	}
+	*p = Posit64{impl: out}
+	return nil
+}
+
+// Posit64SQLBits is a Posit64 which Value always stores in the format SQLBits,
+// whatever DefaultSQLFormat is, Scan accepts every format.
+type Posit64SQLBits struct{ Posit64 }
+
+// Value implements driver.Valuer, the posit is stored in the format SQLBits
+func (p Posit64SQLBits) Value() (driver.Value, error) { return p.slow().sqlValue(SQLBits) }
+
+// Posit64SQLDecimal is a Posit64 which Value always stores in the format SQLDecimal,
+// whatever DefaultSQLFormat is, Scan accepts every format.
+type Posit64SQLDecimal struct{ Posit64 }
+
+// Value implements driver.Valuer, the posit is stored in the format SQLDecimal
+func (p Posit64SQLDecimal) Value() (driver.Value, error) { return p.slow().sqlValue(SQLDecimal) }
@@ -0,0 +1,75 @@
+package goposit
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// SQLFormat selects how Value stores a posit in a database, Scan accepts every format
+type SQLFormat int
+
+const (
+	// SQLBits stores the bits of the posit as a signed integer, posits sort the same way as
+	// their bits do when read as a signed integer so the column can be indexed and compared.
+	// NaR is the smallest integer.
+	SQLBits SQLFormat = iota
+
+	// SQLDecimal stores the shortest decimal string which converts back to the same posit,
+	// NaR is stored as "NaR".
+	SQLDecimal
+)
+
+// DefaultSQLFormat is the format used by Value, it is only a fallback for posits which do
+// not choose their own format with a wrapper such as Posit16SQLDecimal.
+var DefaultSQLFormat = SQLBits
+
+// sqlValue returns the posit as a driver.Value in the format f
+func (p *SlowPosit) sqlValue(f SQLFormat) (driver.Value, error) {
+	switch f {
+	case SQLBits:
+		s := p.signed()
+		if !s.IsInt64() {
+			return nil, fmt.Errorf("a %d bit posit does not fit in an int64", p.nbits)
+		}
+		return s.Int64(), nil
+	case SQLDecimal:
+		return p.decimal(), nil
+	}
+	return nil, fmt.Errorf("unknown SQLFormat %d", f)
+}
+
+// scan sets the posit from a value read from a database, integers are bits, strings are
+// parsed as text and floats are rounded to nearest even with NaN and Inf becoming NaR.
+// NULL is an error because no posit means "no value", NaR should be stored instead.
+func (p *SlowPosit) scan(src any) error {
+	switch v := src.(type) {
+	case int64:
+		bits := big.NewInt(v)
+		min := new(big.Int).Neg(new(big.Int).Lsh(bigi1, p.nbits-1))
+		max := new(big.Int).Sub(new(big.Int).Lsh(bigi1, p.nbits), bigi1)
+		if bits.Cmp(min) < 0 || bits.Cmp(max) > 0 {
+			return fmt.Errorf("%w: %d does not fit in %d bits", ErrEncoding, v, p.nbits)
+		}
+		if v < 0 {
+			bits.Add(bits, new(big.Int).Lsh(bigi1, p.nbits))
+		}
+		p.Bits = bits
+		return nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			p.NaR()
+		} else {
+			p.FromFloat(big.NewFloat(v), false)
+		}
+		return nil
+	case []byte:
+		return p.parse(string(v))
+	case string:
+		return p.parse(v)
+	case nil:
+		return fmt.Errorf("%w: cannot scan NULL into a posit", ErrEncoding)
+	}
+	return fmt.Errorf("%w: cannot scan %T into a posit", ErrEncoding, src)
+}
@@ -0,0 +1,126 @@
+package goposit_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+// memDriver is a stand-in database with one column, "insert" appends a row and "select"
+// returns every row.
+type memDriver struct{ rows []driver.Value }
+
+func (d *memDriver) Open(string) (driver.Conn, error) { return d, nil }
+func (d *memDriver) Prepare(query string) (driver.Stmt, error) {
+	return &memStmt{d: d, query: query}, nil
+}
+func (d *memDriver) Close() error              { return nil }
+func (d *memDriver) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+type memStmt struct {
+	d     *memDriver
+	query string
+}
+
+func (s *memStmt) Close() error { return nil }
+func (s *memStmt) NumInput() int {
+	if s.query == "insert" {
+		return 1
+	}
+	return 0
+}
+func (s *memStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.rows = append(s.d.rows, args[0])
+	return driver.RowsAffected(1), nil
+}
+func (s *memStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &memRows{rows: s.d.rows}, nil
+}
+
+type memRows struct{ rows []driver.Value }
+
+func (r *memRows) Columns() []string { return []string{"v"} }
+func (r *memRows) Close() error      { return nil }
+func (r *memRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	dest[0], r.rows = r.rows[0], r.rows[1:]
+	return nil
+}
+
+// mem is registered once because sql.Register panics if a name is used twice, so that the
+// test can run with -count
+var mem = &memDriver{}
+
+func init() { sql.Register("positmem", mem) }
+
+func TestSQL(t *testing.T) {
+	db, err := sql.Open("positmem", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	in := []goposit.Posit64{goposit.Posit64Pi(), goposit.Posit64NaR(), goposit.Posit64MaxPos(),
+		goposit.Posit64One().NextDown(), goposit.Posit64Zero()}
+	// the wrappers choose the format so that the test does not write DefaultSQLFormat
+	wrap := map[goposit.SQLFormat]func(goposit.Posit64) driver.Valuer{
+		goposit.SQLBits:    func(p goposit.Posit64) driver.Valuer { return goposit.Posit64SQLBits{Posit64: p} },
+		goposit.SQLDecimal: func(p goposit.Posit64) driver.Valuer { return goposit.Posit64SQLDecimal{Posit64: p} },
+	}
+	for _, format := range []goposit.SQLFormat{goposit.SQLBits, goposit.SQLDecimal} {
+		mem.rows = nil
+		for _, p := range in {
+			if _, err := db.Exec("insert", wrap[format](p)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		rows, err := db.Query("select")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out []goposit.Posit64
+		for rows.Next() {
+			var p goposit.Posit64
+			if err := rows.Scan(&p); err != nil {
+				t.Fatal(err)
+			}
+			out = append(out, p)
+		}
+		rows.Close()
+		if len(out) != len(in) {
+			t.Fatalf("format %v: expected %d rows got %d", format, len(in), len(out))
+		}
+		for i := range in {
+			if out[i].Bits() != in[i].Bits() {
+				t.Errorf("format %v: stored %x read back %x (%v)", format, in[i].Bits(), out[i].Bits(), mem.rows[i])
+			}
+		}
+	}
+	if _, ok := mem.rows[0].(string); !ok {
+		t.Errorf("Posit64SQLDecimal should store a string, got %T", mem.rows[0])
+	}
+
+	// NaR is the smallest integer so ordering by the column works
+	if v, _ := goposit.Posit16NaR().Value(); v != int64(-0x8000) {
+		t.Errorf("Posit16 NaR should be stored as -32768, got %v", v)
+	}
+	var p goposit.Posit8
+	if err := p.Scan(int64(0xc0)); err != nil || p.Bits() != 0xc0 {
+		t.Errorf("unsigned bits should scan, got %x (%v)", p.Bits(), err)
+	}
+	if err := p.Scan(int64(0x100)); !errors.Is(err, goposit.ErrEncoding) {
+		t.Errorf("0x100 should not fit in a Posit8")
+	}
+	if err := p.Scan(nil); !errors.Is(err, goposit.ErrEncoding) {
+		t.Errorf("NULL should not scan")
+	}
+	if err := p.Scan(1.5); err != nil || p.Bits() != 0x50 {
+		t.Errorf("1.5 should scan as 0x50, got %x (%v)", p.Bits(), err)
+	}
+}
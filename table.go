@@ -0,0 +1,165 @@
+package goposit
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Small SlowPosits can be served from lookup tables, on first use of an operation for a
+// given nbits and es the result for every input is computed with big.Float and stored so
+// that later calls are a single lookup. Tables are only built within the budget set by
+// SetTableBudget, which is zero by default, so nothing changes unless it is enabled.
+// Only Add, Sub, Mul, Div and Sqrt are tabled because they are the ones which round through
+// big.Float, the other unary operations such as Mant, NextUp and ULP work on the bits
+// directly and cost about as much as a lookup would.
+
+type tableOp int
+
+const (
+	tableAdd tableOp = iota
+	tableMul
+	tableDiv
+	tableSqrt
+)
+
+type tableKey struct {
+	nbits uint
+	es    uint
+	op    tableOp
+}
+
+type opTable struct {
+	once sync.Once
+	vals []uint16
+}
+
+// tables is locked only to add a table, budget is read without the lock so the default of
+// no tables costs nothing and built is copied rather than modified so lookups need no lock.
+var tables struct {
+	sync.Mutex
+	budget atomic.Int64
+	used   int
+	built  atomic.Pointer[map[tableKey]*opTable]
+}
+
+// SetTableBudget sets the number of bytes of lookup tables which SlowPosit may build, zero
+// disables them. A binary operation on n bit posits takes 2 * 4**n bytes, for example 2MB
+// for 10 bit posits, and a unary one takes 2 * 2**n bytes. Tables are built the first
+// time an operation is used and only for posits of at most 16 bits, changing the budget
+// discards every table which has been built.
+func SetTableBudget(bytes int) {
+	tables.Lock()
+	defer tables.Unlock()
+	tables.budget.Store(int64(bytes))
+	tables.used = 0
+	tables.built.Store(nil)
+}
+
+// TableMemory returns the number of bytes used by lookup tables
+func TableMemory() int {
+	tables.Lock()
+	defer tables.Unlock()
+	return tables.used
+}
+
+// table returns the lookup table for an operation on posits like p, building it if
+// needed, or nil if it does not fit in the budget.
+func (p *SlowPosit) table(op tableOp) []uint16 {
+	if p.nbits > 16 || tables.budget.Load() == 0 {
+		return nil
+	}
+	key := tableKey{nbits: p.nbits, es: p.es, op: op}
+	t := builtTable(key)
+	if t == nil {
+		if t = addTable(key); t == nil {
+			return nil
+		}
+	}
+	t.once.Do(func() { t.vals = buildTable(p, op) })
+	return t.vals
+}
+
+// builtTable returns the table for key if it has been added, without locking
+func builtTable(key tableKey) *opTable {
+	if m := tables.built.Load(); m != nil {
+		return (*m)[key]
+	}
+	return nil
+}
+
+// addTable reserves the memory for a table and adds it, or returns nil if it does not fit
+// in the budget. The table is filled in by the first caller of its once.
+func addTable(key tableKey) *opTable {
+	tables.Lock()
+	defer tables.Unlock()
+	if t := builtTable(key); t != nil {
+		return t
+	}
+	// a binary table of 16 bit posits has 1<<32 entries which does not fit in a 32 bit int
+	entries := int64(1) << key.nbits
+	if key.op != tableSqrt {
+		entries <<= key.nbits
+	}
+	if int64(tables.used)+2*entries > tables.budget.Load() {
+		return nil
+	}
+	tables.used += int(2 * entries)
+	m := map[tableKey]*opTable{}
+	if old := tables.built.Load(); old != nil {
+		for k, v := range *old {
+			m[k] = v
+		}
+	}
+	t := &opTable{}
+	m[key] = t
+	tables.built.Store(&m)
+	return t
+}
+
+// buildTable computes an operation for every input
+func buildTable(template *SlowPosit, op tableOp) []uint16 {
+	n := template.nbits
+	x := NewSlowPosit(n, template.es)
+	y := NewSlowPosit(n, template.es)
+	if op == tableSqrt {
+		out := make([]uint16, 1<<n)
+		for i := range out {
+			x.Bits.SetUint64(uint64(i))
+			out[i] = uint16(x.sqrt().Uint64())
+		}
+		return out
+	}
+	out := make([]uint16, 1<<(2*n))
+	for i := 0; i < 1<<n; i++ {
+		x.Bits.SetUint64(uint64(i))
+		// addition and multiplication are commutative so only half needs to be computed
+		j0 := 0
+		if op != tableDiv {
+			j0 = i
+		}
+		for j := j0; j < 1<<n; j++ {
+			y.Bits.SetUint64(uint64(j))
+			var v uint16
+			switch op {
+			case tableAdd:
+				v = uint16(x.add(y).Uint64())
+			case tableMul:
+				v = uint16(x.mul(y).Uint64())
+			default:
+				v = uint16(x.div(y).Uint64())
+			}
+			out[i<<n|j] = v
+			if op != tableDiv {
+				out[j<<n|i] = v
+			}
+		}
+	}
+	return out
+}
+
+// lookup2 returns the result of a binary operation from the table
+func (p *SlowPosit) lookup2(t []uint16, x *SlowPosit) *SlowPosit {
+	out := NewSlowPosit(p.nbits, p.es)
+	out.Bits.SetUint64(uint64(t[p.Bits.Uint64()<<p.nbits|x.Bits.Uint64()]))
+	return out
+}
@@ -0,0 +1,93 @@
+package goposit_test
+
+import (
+	"math"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+// Every result from the tables must match the big.Float path
+func TestTables(t *testing.T) {
+	type result struct{ add, sub, mul, div, sqrt uint64 }
+	const nbits, es = 7, 1
+	compute := func() []result {
+		out := make([]result, 0, 1<<(2*nbits))
+		x := goposit.NewSlowPosit(nbits, es)
+		y := goposit.NewSlowPosit(nbits, es)
+		for i := 0; i < 1<<nbits; i++ {
+			for j := 0; j < 1<<nbits; j++ {
+				x.SetBits(big.NewInt(int64(i)))
+				y.SetBits(big.NewInt(int64(j)))
+				r := result{x.Add(y).Uint64(), x.Sub(y).Uint64(), x.Mul(y).Uint64(), x.Div(y).Uint64(), x.Sqrt().Uint64()}
+				out = append(out, r)
+			}
+		}
+		return out
+	}
+	slow := compute()
+	goposit.SetTableBudget(1 << 20)
+	defer goposit.SetTableBudget(0)
+	fast := compute()
+	if mem := goposit.TableMemory(); mem != 3*2<<(2*nbits)+2<<nbits {
+		t.Errorf("expected 3 binary tables and one unary table, using %d bytes", mem)
+	}
+	for i := range slow {
+		if slow[i] != fast[i] {
+			t.Errorf("%x, %x: big.Float gives %+v tables give %+v", i>>nbits, i&(1<<nbits-1), slow[i], fast[i])
+		}
+	}
+
+	// NaR, division by zero and the square root of a negative number give NaR
+	nar := goposit.NewSlowPosit(nbits, es).NaR()
+	one := goposit.NewSlowPosit(nbits, es).One()
+	zero := goposit.NewSlowPosit(nbits, es).Zero()
+	if !nar.Mul(zero).IsNaR() || !nar.Sub(nar).IsNaR() || !one.Div(zero).IsNaR() || !goposit.NewSlowPosit(nbits, es).NegOne().Sqrt().IsNaR() {
+		t.Errorf("NaR, division by zero or sqrt of a negative should give NaR")
+	}
+
+	// a budget which is too small builds no tables
+	goposit.SetTableBudget(100)
+	goposit.NewSlowPosit(8, 0).One().Add(goposit.NewSlowPosit(8, 0).One())
+	if goposit.TableMemory() != 0 {
+		t.Errorf("a table was built over budget")
+	}
+
+	// a 16 bit binary table is 8GB, which must not overflow to a small size where int is 32 bits
+	goposit.SetTableBudget(math.MaxInt32)
+	goposit.NewSlowPosit(16, 1).One().Add(goposit.NewSlowPosit(16, 1).One())
+	if goposit.TableMemory() != 0 {
+		t.Errorf("a 16 bit binary table was built in a 2GB budget")
+	}
+}
+
+// Tables may be built and read from many goroutines at once, run with -race
+func TestTablesConcurrent(t *testing.T) {
+	goposit.SetTableBudget(1 << 20)
+	defer goposit.SetTableBudget(0)
+	var wg sync.WaitGroup
+	results := make([]uint64, 8)
+	for g := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			one := goposit.NewSlowPosit(8, 1).One()
+			x := one.Clone()
+			for i := 0; i < 100; i++ {
+				x = x.Add(one).Mul(x).Div(one).Sqrt()
+			}
+			results[g] = x.Uint64()
+		}()
+	}
+	wg.Wait()
+	for g := range results {
+		if results[g] != results[0] {
+			t.Errorf("goroutine %d got %x but goroutine 0 got %x", g, results[g], results[0])
+		}
+	}
+	if mem := goposit.TableMemory(); mem != 3*2<<16+2<<8 {
+		t.Errorf("each table should be built once, using %d bytes", mem)
+	}
+}
@@ -0,0 +1,208 @@
+package tensorio
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const npyMagic = "\x93NUMPY"
+
+// npyAlign is the alignment of the start of the data which NumPy uses
+const npyAlign = 64
+
+// containerBytes returns the size of the smallest unsigned integer which holds nbits
+func containerBytes(nbits uint) int {
+	switch {
+	case nbits <= 8:
+		return 1
+	case nbits <= 16:
+		return 2
+	case nbits <= 32:
+		return 4
+	}
+	return 8
+}
+
+// defaultEs returns es of the Posit8, Posit16, Posit32 or Posit64 stored in a container of
+// the given size, it is used when an .npy file does not say what posit it holds.
+func defaultEs(size int) uint {
+	switch size {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	case 4:
+		return 2
+	}
+	return 3
+}
+
+// WriteNPY writes the tensor as a NumPy .npy file of unsigned integers, each holding the
+// bits of one posit in the smallest of uint8, uint16, uint32 or uint64 which fits. nbits
+// and es are stored as a comment after the header dictionary, where NumPy ignores them.
+func WriteNPY(w io.Writer, t *Tensor, order binary.ByteOrder) error {
+	if err := t.check(); err != nil {
+		return err
+	}
+	size := containerBytes(t.Nbits)
+	descr := fmt.Sprintf(">u%d", size)
+	if size == 1 {
+		descr = "|u1"
+	} else if isLittle(order) {
+		descr = fmt.Sprintf("<u%d", size)
+	}
+	dims := make([]string, len(t.Shape))
+	for i, d := range t.Shape {
+		dims[i] = strconv.Itoa(d)
+	}
+	shape := strings.Join(dims, ", ")
+	if len(dims) == 1 {
+		shape += ","
+	}
+	hdr := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%s), } # posit nbits=%d es=%d",
+		descr, shape, t.Nbits, t.Es)
+
+	// version 1 has a 2 byte header length and version 2 has 4 bytes, the header is padded
+	// with spaces and ends with a newline so that the data is aligned
+	major, lenBytes := byte(1), 2
+	if len(hdr) > 0xffff-npyAlign {
+		major, lenBytes = 2, 4
+	}
+	pad := (npyAlign - (len(npyMagic)+2+lenBytes+len(hdr)+1)%npyAlign) % npyAlign
+	hdr += strings.Repeat(" ", pad) + "\n"
+	out := append([]byte(npyMagic), major, 0)
+	if major == 1 {
+		out = binary.LittleEndian.AppendUint16(out, uint16(len(hdr)))
+	} else {
+		out = binary.LittleEndian.AppendUint32(out, uint32(len(hdr)))
+	}
+	out = append(out, hdr...)
+	if _, err := w.Write(out); err != nil {
+		return err
+	}
+
+	data := make([]byte, size*len(t.Bits))
+	for i, b := range t.Bits {
+		switch size {
+		case 1:
+			data[i] = byte(b)
+		case 2:
+			order.PutUint16(data[2*i:], uint16(b))
+		case 4:
+			order.PutUint32(data[4*i:], uint32(b))
+		default:
+			order.PutUint64(data[8*i:], b)
+		}
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+var (
+	npyDescr   = regexp.MustCompile(`'descr':\s*'([<>|=])([ui])([1248])'`)
+	npyFortran = regexp.MustCompile(`'fortran_order':\s*(True|False)`)
+	npyShape   = regexp.MustCompile(`'shape':\s*\(([0-9,\s]*)\)`)
+	npyPosit   = regexp.MustCompile(`#\s*posit nbits=([0-9]+) es=([0-9]+)`)
+)
+
+// ReadNPY reads a NumPy .npy file of 8, 16, 32 or 64 bit integers. If the file was written
+// by WriteNPY then nbits and es are read from the header, otherwise the file is taken to
+// hold Posit8, Posit16, Posit32 or Posit64 depending on the integer size.
+func ReadNPY(r io.Reader) (*Tensor, error) {
+	var pre [len(npyMagic) + 2]byte
+	if _, err := io.ReadFull(r, pre[:]); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrFormat, err)
+	}
+	if string(pre[:len(npyMagic)]) != npyMagic {
+		return nil, fmt.Errorf("%w: bad .npy magic", ErrFormat)
+	}
+	var hdrLen uint64
+	switch pre[len(npyMagic)] {
+	case 1:
+		var l [2]byte
+		if _, err := io.ReadFull(r, l[:]); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrFormat, err)
+		}
+		hdrLen = uint64(binary.LittleEndian.Uint16(l[:]))
+	case 2, 3:
+		var l [4]byte
+		if _, err := io.ReadFull(r, l[:]); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrFormat, err)
+		}
+		hdrLen = uint64(binary.LittleEndian.Uint32(l[:]))
+	default:
+		return nil, fmt.Errorf("%w: unsupported .npy version %d", ErrFormat, pre[len(npyMagic)])
+	}
+	hdrBytes, err := readFull(r, hdrLen)
+	if err != nil {
+		return nil, err
+	}
+	hdr := string(hdrBytes)
+
+	descr := npyDescr.FindStringSubmatch(hdr)
+	if descr == nil {
+		return nil, fmt.Errorf("%w: dtype is not an 8, 16, 32 or 64 bit integer", ErrFormat)
+	}
+	if f := npyFortran.FindStringSubmatch(hdr); f == nil || f[1] != "False" {
+		return nil, fmt.Errorf("%w: only C order arrays are supported", ErrFormat)
+	}
+	shape := npyShape.FindStringSubmatch(hdr)
+	if shape == nil {
+		return nil, fmt.Errorf("%w: missing shape", ErrFormat)
+	}
+
+	size := int(descr[3][0] - '0')
+	t := &Tensor{Nbits: uint(8 * size), Es: defaultEs(size), Shape: []int{}}
+	if p := npyPosit.FindStringSubmatch(hdr); p != nil {
+		nbits, _ := strconv.ParseUint(p[1], 10, 8)
+		es, _ := strconv.ParseUint(p[2], 10, 8)
+		t.Nbits, t.Es = uint(nbits), uint(es)
+	}
+	if t.Nbits > uint(8*size) || t.Nbits <= t.Es+3 {
+		return nil, fmt.Errorf("%w: nbits %d and es %d in a %d byte integer", ErrFormat, t.Nbits, t.Es, size)
+	}
+	for _, d := range strings.Split(shape[1], ",") {
+		if d = strings.TrimSpace(d); d == "" {
+			continue
+		}
+		v, err := strconv.Atoi(d)
+		if err != nil {
+			return nil, fmt.Errorf("%w: bad shape %q", ErrFormat, shape[1])
+		}
+		t.Shape = append(t.Shape, v)
+	}
+	n, ok := count(t.Shape)
+	if !ok || uint64(n) > 1<<56 {
+		return nil, fmt.Errorf("%w: shape %v is too large", ErrFormat, t.Shape)
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if descr[1] == ">" {
+		order = binary.BigEndian
+	}
+	data, err := readFull(r, uint64(n)*uint64(size))
+	if err != nil {
+		return nil, err
+	}
+	t.Bits = make([]uint64, n)
+	for i := range t.Bits {
+		switch size {
+		case 1:
+			t.Bits[i] = uint64(data[i])
+		case 2:
+			t.Bits[i] = uint64(order.Uint16(data[2*i:]))
+		case 4:
+			t.Bits[i] = uint64(order.Uint32(data[4*i:]))
+		default:
+			t.Bits[i] = order.Uint64(data[8*i:])
+		}
+	}
+	if err := t.check(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
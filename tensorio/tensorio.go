@@ -0,0 +1,258 @@
+// Package tensorio reads and writes arrays of posits without losing their bit patterns.
+// The native format is self describing and packs values densely so odd widths such as
+// 6 or 12 bit SlowPosits take no more space than they need, there is also a NumPy .npy
+// mode which stores each posit as an unsigned integer.
+//
+// The native format begins with a header, every multi-byte field is in the byte order of
+// the file:
+//
+//	magic    "PTEN"
+//	version  1 byte, currently 1
+//	order    1 byte, 'L' for little endian or 'B' for big endian
+//	nbits    1 byte
+//	es       1 byte
+//	ndim     uint32
+//	shape    ndim uint64s, the outermost dimension first
+//
+// It is followed by the values in row-major order, packed into a bitstream of nbits per
+// value and padded with zeros to a whole byte. In a little endian file the bitstream is
+// filled from the least significant bit of each byte and each value is written least
+// significant bit first, in a big endian file it is the other way around, so when nbits
+// is a multiple of 8 the values are ordinary integers of that byte order.
+package tensorio
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/cjdelisle/goposit"
+)
+
+const magic = "PTEN"
+const version = 1
+
+// ErrFormat is returned when a file is not a valid posit tensor
+var ErrFormat = errors.New("not a valid posit tensor")
+
+// ErrSize is returned when converting a tensor to a posit type of a different size
+var ErrSize = errors.New("tensor has a different posit size")
+
+// Tensor is an n-dimensional array of posits stored as their bits, Shape lists the
+// dimensions from the outermost and Bits holds the values in row-major order.
+type Tensor struct {
+	Nbits uint
+	Es    uint
+	Shape []int
+	Bits  []uint64
+}
+
+// New creates a tensor of zeros, nbits may be at most 64
+func New(nbits, es uint, shape ...int) *Tensor {
+	if nbits > 64 {
+		panic("tensorio supports posits of at most 64 bits")
+	}
+	goposit.NewSlowPosit(nbits, es)
+	n, ok := count(shape)
+	if !ok {
+		panic("invalid tensor shape")
+	}
+	return &Tensor{Nbits: nbits, Es: es, Shape: append([]int(nil), shape...), Bits: make([]uint64, n)}
+}
+
+// count returns the number of values in a shape
+func count(shape []int) (int, bool) {
+	n := 1
+	for _, d := range shape {
+		if d < 0 || (d > 0 && n > int(^uint(0)>>1)/d) {
+			return 0, false
+		}
+		n *= d
+	}
+	return n, true
+}
+
+// Len returns the number of values in the tensor
+func (t *Tensor) Len() int { return len(t.Bits) }
+
+// At returns value i in row-major order as a SlowPosit
+func (t *Tensor) At(i int) *goposit.SlowPosit {
+	out := goposit.NewSlowPosit(t.Nbits, t.Es)
+	out.SetBits(new(big.Int).SetUint64(t.Bits[i]))
+	return out
+}
+
+// Set sets value i in row-major order, p must be the same size as the tensor
+func (t *Tensor) Set(i int, p *goposit.SlowPosit) {
+	if p.Nbits() != t.Nbits || p.Es() != t.Es {
+		panic("posit is not the same size as the tensor")
+	}
+	t.Bits[i] = p.Uint64()
+}
+
+// FromSlow creates a tensor from SlowPosits which must all be the same size
+func FromSlow(shape []int, x []*goposit.SlowPosit) *Tensor {
+	if len(x) == 0 {
+		panic("FromSlow needs at least one value to know the posit size")
+	}
+	t := New(x[0].Nbits(), x[0].Es(), shape...)
+	if t.Len() != len(x) {
+		panic("shape does not match the number of values")
+	}
+	for i, p := range x {
+		t.Set(i, p)
+	}
+	return t
+}
+
+// Slow returns every value of the tensor as a SlowPosit
+func (t *Tensor) Slow() []*goposit.SlowPosit {
+	out := make([]*goposit.SlowPosit, t.Len())
+	for i := range out {
+		out[i] = t.At(i)
+	}
+	return out
+}
+
+// check verifies that the tensor is consistent before it is written
+func (t *Tensor) check() error {
+	if t.Nbits > 64 || t.Nbits <= t.Es+3 {
+		return fmt.Errorf("%w: nbits %d and es %d", ErrFormat, t.Nbits, t.Es)
+	}
+	if n, ok := count(t.Shape); !ok || n != len(t.Bits) {
+		return fmt.Errorf("%w: shape %v does not match %d values", ErrFormat, t.Shape, len(t.Bits))
+	}
+	for _, b := range t.Bits {
+		if t.Nbits < 64 && b>>t.Nbits != 0 {
+			return fmt.Errorf("%w: %x does not fit in %d bits", ErrFormat, b, t.Nbits)
+		}
+	}
+	return nil
+}
+
+// pack writes the values into a bitstream of nbits each
+func pack(values []uint64, nbits uint, little bool) []byte {
+	out := make([]byte, (uint(len(values))*nbits+7)/8)
+	pos := uint(0)
+	for _, v := range values {
+		for j := uint(0); j < nbits; j++ {
+			if little {
+				out[pos/8] |= byte(v>>j&1) << (pos % 8)
+			} else {
+				out[pos/8] |= byte(v>>(nbits-1-j)&1) << (7 - pos%8)
+			}
+			pos++
+		}
+	}
+	return out
+}
+
+// unpack reads values of nbits each from a bitstream
+func unpack(data []byte, n int, nbits uint, little bool) []uint64 {
+	out := make([]uint64, n)
+	pos := uint(0)
+	for i := range out {
+		for j := uint(0); j < nbits; j++ {
+			if little {
+				out[i] |= uint64(data[pos/8]>>(pos%8)&1) << j
+			} else {
+				out[i] |= uint64(data[pos/8]>>(7-pos%8)&1) << (nbits - 1 - j)
+			}
+			pos++
+		}
+	}
+	return out
+}
+
+// isLittle checks the byte order by decoding a known value, this works for any order
+func isLittle(order binary.ByteOrder) bool {
+	return order.Uint16([]byte{1, 0}) == 1
+}
+
+// Write writes the tensor in the native format using the byte order specified
+func Write(w io.Writer, t *Tensor, order binary.ByteOrder) error {
+	if err := t.check(); err != nil {
+		return err
+	}
+	little := isLittle(order)
+	hdr := []byte(magic)
+	orderByte := byte('B')
+	if little {
+		orderByte = 'L'
+	}
+	hdr = append(hdr, version, orderByte, byte(t.Nbits), byte(t.Es))
+	hdr = append(hdr, make([]byte, 4+8*len(t.Shape))...)
+	order.PutUint32(hdr[8:], uint32(len(t.Shape)))
+	for i, d := range t.Shape {
+		order.PutUint64(hdr[12+8*i:], uint64(d))
+	}
+	if _, err := w.Write(hdr); err != nil {
+		return err
+	}
+	_, err := w.Write(pack(t.Bits, t.Nbits, little))
+	return err
+}
+
+// readFull reads exactly n bytes without allocating them all up front, so that a damaged
+// header cannot cause a huge allocation.
+func readFull(r io.Reader, n uint64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, int64(n)))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(data)) != n {
+		return nil, fmt.Errorf("%w: expected %d bytes of data, got %d", ErrFormat, n, len(data))
+	}
+	return data, nil
+}
+
+// Read reads a tensor in the native format
+func Read(r io.Reader) (*Tensor, error) {
+	var hdr [12]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrFormat, err)
+	}
+	if string(hdr[:4]) != magic {
+		return nil, fmt.Errorf("%w: bad magic", ErrFormat)
+	}
+	if hdr[4] != version {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrFormat, hdr[4])
+	}
+	var order binary.ByteOrder
+	switch hdr[5] {
+	case 'L':
+		order = binary.LittleEndian
+	case 'B':
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("%w: unknown byte order %q", ErrFormat, hdr[5])
+	}
+	t := &Tensor{Nbits: uint(hdr[6]), Es: uint(hdr[7])}
+	if t.Nbits > 64 || t.Nbits <= t.Es+3 {
+		return nil, fmt.Errorf("%w: nbits %d and es %d", ErrFormat, t.Nbits, t.Es)
+	}
+	dims, err := readFull(r, 8*uint64(order.Uint32(hdr[8:])))
+	if err != nil {
+		return nil, err
+	}
+	t.Shape = make([]int, len(dims)/8)
+	for i := range t.Shape {
+		d := order.Uint64(dims[8*i:])
+		if d > uint64(^uint(0)>>1) {
+			return nil, fmt.Errorf("%w: dimension %d is too large", ErrFormat, d)
+		}
+		t.Shape[i] = int(d)
+	}
+	n, ok := count(t.Shape)
+	if !ok || uint64(n) > 1<<56 {
+		return nil, fmt.Errorf("%w: shape %v is too large", ErrFormat, t.Shape)
+	}
+	data, err := readFull(r, (uint64(n)*uint64(t.Nbits)+7)/8)
+	if err != nil {
+		return nil, err
+	}
+	t.Bits = unpack(data, n, t.Nbits, order == binary.LittleEndian)
+	return t, nil
+}
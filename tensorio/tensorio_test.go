@@ -0,0 +1,101 @@
+package tensorio_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+	"github.com/cjdelisle/goposit/tensorio"
+)
+
+func TestNative(t *testing.T) {
+	// 12 bit posits pack 2 values in 3 bytes
+	x := make([]*goposit.SlowPosit, 6)
+	for i := range x {
+		x[i] = goposit.NewSlowPosit(12, 1)
+		x[i].SetBits(big.NewInt(int64(0x123 * (i + 1))))
+	}
+	in := tensorio.FromSlow([]int{2, 3}, x)
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		var buf bytes.Buffer
+		if err := tensorio.Write(&buf, in, order); err != nil {
+			t.Fatal(err)
+		}
+		// header is 12 bytes plus 2 dimensions, then 6 * 12 bits of data
+		if buf.Len() != 12+16+9 {
+			t.Errorf("expected %d bytes got %d", 12+16+9, buf.Len())
+		}
+		data := buf.Bytes()[28:]
+		if order == binary.BigEndian && !bytes.Equal(data[:3], []byte{0x12, 0x32, 0x46}) {
+			t.Errorf("big endian should be packed most significant bit first, got %x", data[:3])
+		}
+		if order == binary.LittleEndian && !bytes.Equal(data[:3], []byte{0x23, 0x61, 0x24}) {
+			t.Errorf("little endian should be packed least significant bit first, got %x", data[:3])
+		}
+		out, err := tensorio.Read(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out.Nbits != 12 || out.Es != 1 || len(out.Shape) != 2 || out.Shape[0] != 2 || out.Shape[1] != 3 {
+			t.Fatalf("header did not round trip: %+v", out)
+		}
+		for i, p := range out.Slow() {
+			if p.Uint64() != x[i].Uint64() {
+				t.Errorf("value %d: expected %x got %x", i, x[i].Uint64(), p.Uint64())
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	tensorio.Write(&buf, in, binary.LittleEndian)
+	if _, err := tensorio.Read(bytes.NewReader(buf.Bytes()[:buf.Len()-1])); !errors.Is(err, tensorio.ErrFormat) {
+		t.Errorf("a truncated file should be ErrFormat, got %v", err)
+	}
+}
+
+func TestNPY(t *testing.T) {
+	p := goposit.NewPosit16()
+	in := tensorio.FromPosit16([]int{3}, []goposit.Posit16{p.SetBits(0x4000), p.SetBits(0x8000), p.SetBits(0x1234)})
+	var buf bytes.Buffer
+	if err := tensorio.WriteNPY(&buf, in, binary.LittleEndian); err != nil {
+		t.Fatal(err)
+	}
+	b := buf.Bytes()
+	hdrLen := int(binary.LittleEndian.Uint16(b[8:]))
+	if (10+hdrLen)%64 != 0 || b[10+hdrLen-1] != '\n' {
+		t.Errorf("data should be aligned to 64 bytes after a newline, header is %d bytes", hdrLen)
+	}
+	hdr := string(b[10 : 10+hdrLen])
+	expect := "{'descr': '<u2', 'fortran_order': False, 'shape': (3,), } # posit nbits=16 es=1"
+	if hdr[:len(expect)] != expect {
+		t.Errorf("expected header %q got %q", expect, hdr)
+	}
+	if !bytes.Equal(b[10+hdrLen:], []byte{0x00, 0x40, 0x00, 0x80, 0x34, 0x12}) {
+		t.Errorf("data should be little endian uint16, got %x", b[10+hdrLen:])
+	}
+	out, err := tensorio.ReadNPY(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := out.Posit16()
+	if err != nil || len(ps) != 3 || ps[0].Bits() != 0x4000 || ps[1].Bits() != 0x8000 || ps[2].Bits() != 0x1234 {
+		t.Errorf("Posit16 did not round trip through .npy (%v)", err)
+	}
+	if _, err := out.Posit32(); !errors.Is(err, tensorio.ErrSize) {
+		t.Errorf("reading Posit16 as Posit32 should be ErrSize, got %v", err)
+	}
+
+	// a plain uint8 file from NumPy is read as Posit8
+	plain := "\x93NUMPY\x01\x00\x3c\x00{'descr': '|u1', 'fortran_order': False, 'shape': (2, 1), }\n\x40\xc0"
+	out, err = tensorio.ReadNPY(bytes.NewReader([]byte(plain)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p8, err := out.Posit8()
+	if err != nil || p8[0].Bits() != 0x40 || p8[1].Bits() != 0xc0 || out.Shape[0] != 2 {
+		t.Errorf("plain uint8 .npy should read as Posit8 (%v)", err)
+	}
+}
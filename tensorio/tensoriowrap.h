@@ -0,0 +1,26 @@
+
+__COMMENT__ GLUE(From, POSIT_T) creates a tensor from POSIT_T values in row-major order
+func GLUE(From, POSIT_T)(shape []int, x []goposit.POSIT_T) *Tensor {
+    t := New(NBITS, ES, shape...)
+    if t.Len() != len(x) {
+        panic("shape does not match the number of values")
+    }
+    for i, p := range x {
+        t.Bits[i] = uint64(p.Bits())
+    }
+    return t
+}
+
+__COMMENT__ POSIT_T returns the values of the tensor as POSIT_T in row-major order, if the
+__COMMENT__ tensor holds posits of a different size then ErrSize is returned.
+func (t *Tensor) POSIT_T() ([]goposit.POSIT_T, error) {
+    p := goposit.GLUE(New, POSIT_T)()
+    if t.Nbits != NBITS || t.Es != ES {
+        return nil, fmt.Errorf("%w: %d bit es %d is not a %T", ErrSize, t.Nbits, t.Es, p)
+    }
+    out := make([]goposit.POSIT_T, t.Len())
+    for i, b := range t.Bits {
+        out[i] = p.SetBits(UWORD(b))
+    }
+    return out, nil
+}
@@ -0,0 +1,110 @@
+package tensorio
+
+import (
+	"fmt"
+	"github.com/cjdelisle/goposit"
+)
+
+// FromPosit8 creates a tensor from Posit8 values in row-major order
+func FromPosit8(shape []int, x []goposit.Posit8) *Tensor {
+	t := New(8, 0, shape...)
+	if t.Len() != len(x) {
+		panic("shape does not match the number of values")
+	}
+	for i, p := range x {
+		t.Bits[i] = uint64(p.Bits())
+	}
+	return t
+}
+
+// Posit8 returns the values of the tensor as Posit8 in row-major order, if the
+// tensor holds posits of a different size then ErrSize is returned.
+func (t *Tensor) Posit8() ([]goposit.Posit8, error) {
+	p := goposit.NewPosit8()
+	if t.Nbits != 8 || t.Es != 0 {
+		return nil, fmt.Errorf("%w: %d bit es %d is not a %T", ErrSize, t.Nbits, t.Es, p)
+	}
+	out := make([]goposit.Posit8, t.Len())
+	for i, b := range t.Bits {
+		out[i] = p.SetBits(uint8(b))
+	}
+	return out, nil
+}
+
+// FromPosit16 creates a tensor from Posit16 values in row-major order
+func FromPosit16(shape []int, x []goposit.Posit16) *Tensor {
+	t := New(16, 1, shape...)
+	if t.Len() != len(x) {
+		panic("shape does not match the number of values")
+	}
+	for i, p := range x {
+		t.Bits[i] = uint64(p.Bits())
+	}
+	return t
+}
+
+// Posit16 returns the values of the tensor as Posit16 in row-major order, if the
+// tensor holds posits of a different size then ErrSize is returned.
+func (t *Tensor) Posit16() ([]goposit.Posit16, error) {
+	p := goposit.NewPosit16()
+	if t.Nbits != 16 || t.Es != 1 {
+		return nil, fmt.Errorf("%w: %d bit es %d is not a %T", ErrSize, t.Nbits, t.Es, p)
+	}
+	out := make([]goposit.Posit16, t.Len())
+	for i, b := range t.Bits {
+		out[i] = p.SetBits(uint16(b))
+	}
+	return out, nil
+}
+
+// FromPosit32 creates a tensor from Posit32 values in row-major order
+func FromPosit32(shape []int, x []goposit.Posit32) *Tensor {
+	t := New(32, 2, shape...)
+	if t.Len() != len(x) {
+		panic("shape does not match the number of values")
+	}
+	for i, p := range x {
+		t.Bits[i] = uint64(p.Bits())
+	}
+	return t
+}
+
+// Posit32 returns the values of the tensor as Posit32 in row-major order, if the
+// tensor holds posits of a different size then ErrSize is returned.
+func (t *Tensor) Posit32() ([]goposit.Posit32, error) {
+	p := goposit.NewPosit32()
+	if t.Nbits != 32 || t.Es != 2 {
+		return nil, fmt.Errorf("%w: %d bit es %d is not a %T", ErrSize, t.Nbits, t.Es, p)
+	}
+	out := make([]goposit.Posit32, t.Len())
+	for i, b := range t.Bits {
+		out[i] = p.SetBits(uint32(b))
+	}
+	return out, nil
+}
+
+// FromPosit64 creates a tensor from Posit64 values in row-major order
+func FromPosit64(shape []int, x []goposit.Posit64) *Tensor {
+	t := New(64, 3, shape...)
+	if t.Len() != len(x) {
+		panic("shape does not match the number of values")
+	}
+	for i, p := range x {
+		t.Bits[i] = uint64(p.Bits())
+	}
+	return t
+}
+
+// Posit64 returns the values of the tensor as Posit64 in row-major order, if the
+// tensor holds posits of a different size then ErrSize is returned.
+func (t *Tensor) Posit64() ([]goposit.Posit64, error) {
+	p := goposit.NewPosit64()
+	if t.Nbits != 64 || t.Es != 3 {
+		return nil, fmt.Errorf("%w: %d bit es %d is not a %T", ErrSize, t.Nbits, t.Es, p)
+	}
+	out := make([]goposit.Posit64, t.Len())
+	for i, b := range t.Bits {
+		out[i] = p.SetBits(uint64(b))
+	}
+	return out, nil
+}
@@ -0,0 +1,98 @@
+// softposit.c writes the vectors in this directory with results from SoftPosit, the inputs
+// are the same as those of the checked in files. Build and run it from this directory with
+//
+//	cc -I$SOFTPOSIT/source/include softposit.c $SOFTPOSIT/build/Linux-x86_64-GCC/softposit.a -lm
+//	./a.out
+//
+// where $SOFTPOSIT is a checkout of https://gitlab.com/cerlane/SoftPosit which has been
+// built with make in build/Linux-x86_64-GCC.
+
+#include <stdint.h>
+#include <stdio.h>
+
+#include "softposit.h"
+
+enum { OP_ADD, OP_MUL, OP_DIV, OP_SQRT };
+static const char *names[] = {"add", "mul", "div", "sqrt"};
+
+static uint64_t eval(int nbits, int op, uint64_t a, uint64_t b)
+{
+    switch (nbits) {
+    case 8: {
+        posit8_t x = castP8(a), y = castP8(b);
+        switch (op) {
+        case OP_ADD: return castUI(p8_add(x, y));
+        case OP_MUL: return castUI(p8_mul(x, y));
+        case OP_DIV: return castUI(p8_div(x, y));
+        default: return castUI(p8_sqrt(x));
+        }
+    }
+    case 16: {
+        posit16_t x = castP16(a), y = castP16(b);
+        switch (op) {
+        case OP_ADD: return castUI(p16_add(x, y));
+        case OP_MUL: return castUI(p16_mul(x, y));
+        case OP_DIV: return castUI(p16_div(x, y));
+        default: return castUI(p16_sqrt(x));
+        }
+    }
+    default: {
+        posit32_t x = castP32(a), y = castP32(b);
+        switch (op) {
+        case OP_ADD: return castUI(p32_add(x, y));
+        case OP_MUL: return castUI(p32_mul(x, y));
+        case OP_DIV: return castUI(p32_div(x, y));
+        default: return castUI(p32_sqrt(x));
+        }
+    }
+    }
+}
+
+static int write(int nbits, int es, int op)
+{
+    char name[32];
+    snprintf(name, sizeof name, "p%d_%s.txt", nbits, names[op]);
+    FILE *f = fopen(name, "w");
+    if (!f) {
+        perror(name);
+        return 1;
+    }
+    fprintf(f, "# p%d_%s, posit %d %d, TestFloat layout without flags\n", nbits, names[op], nbits, es);
+    fprintf(f, "# expected results from SoftPosit, written by softposit.c\n");
+
+    uint64_t mask = (1ull << nbits) - 1, nar = 1ull << (nbits - 1), one = nar >> 1;
+    uint64_t in[40][2] = {{one, one}, {one, 0}, {nar, one}, {nar - 1, nar - 1}, {1, 1},
+                          {one, -one & mask}, {nar - 1, 1}, {0, 0}};
+    uint32_t x = 1;
+    for (int i = 8; i < 40; i++) {
+        for (int j = 0; j < 2; j++) {
+            x = x * 1664525u + 1013904223u;
+            in[i][j] = x >> (32 - nbits);
+        }
+    }
+
+    int w = nbits / 4;
+    for (int i = 0; i < 40; i++) {
+        uint64_t a = in[i][0], b = in[i][1], r = eval(nbits, op, a, b) & mask;
+        if (op == OP_SQRT) {
+            fprintf(f, "%0*llx %0*llx\n", w, (unsigned long long)a, w, (unsigned long long)r);
+        } else {
+            fprintf(f, "%0*llx %0*llx %0*llx\n", w, (unsigned long long)a, w, (unsigned long long)b,
+                    w, (unsigned long long)r);
+        }
+    }
+    return fclose(f) != 0;
+}
+
+int main(void)
+{
+    static const int sizes[][2] = {{8, 0}, {16, 1}, {32, 2}};
+    for (int s = 0; s < 3; s++) {
+        for (int op = OP_ADD; op <= OP_SQRT; op++) {
+            if (write(sizes[s][0], sizes[s][1], op)) {
+                return 1;
+            }
+        }
+    }
+    return 0;
+}
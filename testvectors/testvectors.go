@@ -0,0 +1,432 @@
+// Package testvectors reads, writes, runs and generates golden test vectors for posit
+// arithmetic so results can be cross-checked against other implementations such as
+// SoftPosit or a hardware design.
+//
+// A vector file is plain text with one vector per line:
+//
+//	# comments and blank lines are ignored
+//	posit 16 1
+//	add 4000 4000 5000 00
+//	sqrt 3000 3800 01
+//	op div
+//	4000 0000 8000 10
+//
+// The posit line gives nbits and es and must come before any vector. Each vector is the
+// operation, the operands and the expected result as hex bit patterns, followed by the
+// exception flags as two hex digits like TestFloat. The flags may be left out, they are
+// then not checked. After an op line the operation may also be left out, so the output
+// of a TestFloat style generator, which writes one operation per file with no names, can
+// be read by ReadTestFloat or by adding a posit and op line to the top.
+package testvectors
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/cjdelisle/goposit"
+)
+
+// ErrFormat is returned when a vector file cannot be parsed
+var ErrFormat = errors.New("invalid test vector file")
+
+// ErrSize is returned when running vectors against an implementation of a different size
+var ErrSize = errors.New("vectors are for a different posit size")
+
+// Op is an operation named as in a vector file
+type Op string
+
+const (
+	Add  Op = "add"
+	Sub  Op = "sub"
+	Mul  Op = "mul"
+	Div  Op = "div"
+	Sqrt Op = "sqrt"
+)
+
+// Ops lists every operation in the order Generate uses
+var Ops = []Op{Add, Sub, Mul, Div, Sqrt}
+
+// Arity returns the number of operands of the operation or 0 if it is not known
+func (op Op) Arity() int {
+	switch op {
+	case Add, Sub, Mul, Div:
+		return 2
+	case Sqrt:
+		return 1
+	}
+	return 0
+}
+
+// parseOp accepts an operation name either bare or with a TestFloat style size prefix
+// such as p16_add.
+func parseOp(s string) (Op, bool) {
+	if i := strings.IndexByte(s, '_'); i > 0 && s[0] == 'p' {
+		if _, err := strconv.Atoi(s[1:i]); err == nil {
+			s = s[i+1:]
+		}
+	}
+	op := Op(strings.ToLower(s))
+	return op, op.Arity() != 0
+}
+
+// Flags are exception flags, the values are those used by TestFloat
+type Flags uint8
+
+const (
+	// FlagInexact means the result was rounded
+	FlagInexact Flags = 0x01
+
+	// FlagInvalid means the result is NaR although no operand was NaR, for example a
+	// division by zero or the square root of a negative number.
+	FlagInvalid Flags = 0x10
+
+	// FlagsUnknown is used for vectors which were read without flags, they are not checked
+	FlagsUnknown Flags = 0xff
+)
+
+// Vector is one test case
+type Vector struct {
+	Op       Op
+	Operands []uint64
+	Result   uint64
+	Flags    Flags
+
+	// Line is the line of the file the vector was read from, or 0
+	Line int
+}
+
+// File is a list of vectors for one posit size, vectors must fit in 64 bits
+type File struct {
+	Nbits   uint
+	Es      uint
+	Vectors []Vector
+}
+
+// New creates a file with no vectors
+func New(nbits, es uint) *File {
+	if nbits > 64 {
+		panic("testvectors supports posits of at most 64 bits")
+	}
+	goposit.NewSlowPosit(nbits, es)
+	return &File{Nbits: nbits, Es: es}
+}
+
+func (f *File) mask() uint64 { return ^uint64(0) >> (64 - f.Nbits) }
+
+func (f *File) hex(bits uint64) string { return fmt.Sprintf("%0*x", (f.Nbits+3)/4, bits) }
+
+// Read reads a vector file
+func Read(r io.Reader) (*File, error) {
+	return read(r, nil, "")
+}
+
+// ReadTestFloat reads the output of a TestFloat style generator, which has no posit or op
+// lines, as vectors for op on posits of the given size.
+func ReadTestFloat(r io.Reader, nbits, es uint, op Op) (*File, error) {
+	if op.Arity() == 0 {
+		return nil, fmt.Errorf("%w: unknown operation %q", ErrFormat, op)
+	}
+	return read(r, New(nbits, es), op)
+}
+
+func read(r io.Reader, f *File, op Op) (*File, error) {
+	s := bufio.NewScanner(r)
+	line := 0
+	for s.Scan() {
+		line++
+		text, _, _ := strings.Cut(s.Text(), "#")
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		bad := func(format string, a ...any) error {
+			return fmt.Errorf("%w: line %d: %s", ErrFormat, line, fmt.Sprintf(format, a...))
+		}
+		switch fields[0] {
+		case "posit":
+			var nbits, es uint64
+			var err error
+			if len(fields) == 3 {
+				if nbits, err = strconv.ParseUint(fields[1], 10, 8); err == nil {
+					es, err = strconv.ParseUint(fields[2], 10, 8)
+				}
+			}
+			if len(fields) != 3 || err != nil || nbits > 64 || nbits <= es+3 {
+				return nil, bad("expected posit <nbits> <es>")
+			}
+			if f != nil {
+				return nil, bad("posit size given twice")
+			}
+			f = New(uint(nbits), uint(es))
+			continue
+		case "op":
+			var ok bool
+			if len(fields) != 2 {
+				return nil, bad("expected op <operation>")
+			}
+			if op, ok = parseOp(fields[1]); !ok {
+				return nil, bad("unknown operation %q", fields[1])
+			}
+			continue
+		}
+		if f == nil {
+			return nil, bad("vector before the posit size")
+		}
+		v := Vector{Op: op, Flags: FlagsUnknown, Line: line}
+		// add is also a hex number, so with a default operation it is only taken as a name
+		// if the line would not be a whole vector without it
+		_, err := strconv.ParseUint(fields[0], 16, 64)
+		unnamed := op != "" && err == nil && (len(fields) == op.Arity()+1 || len(fields) == op.Arity()+2)
+		if o, ok := parseOp(fields[0]); ok && !unnamed {
+			v.Op = o
+			fields = fields[1:]
+		} else if op == "" {
+			return nil, bad("unknown operation %q", fields[0])
+		}
+		n := v.Op.Arity()
+		if len(fields) != n+1 && len(fields) != n+2 {
+			return nil, bad("%v needs %d operands and a result", v.Op, n)
+		}
+		bits := make([]uint64, len(fields))
+		for i, x := range fields {
+			b, err := strconv.ParseUint(x, 16, 64)
+			if err != nil || (i <= n && b&^f.mask() != 0) {
+				return nil, bad("%q is not a %d bit hex value", x, f.Nbits)
+			}
+			bits[i] = b
+		}
+		v.Operands, v.Result = bits[:n], bits[n]
+		if len(bits) == n+2 {
+			if bits[n+1] > 0xff {
+				return nil, bad("flags %q are more than 2 hex digits", fields[n+1])
+			}
+			v.Flags = Flags(bits[n+1])
+		}
+		f.Vectors = append(f.Vectors, v)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if f == nil {
+		return nil, fmt.Errorf("%w: no posit size", ErrFormat)
+	}
+	return f, nil
+}
+
+// Write writes the vectors in the format read by Read
+func Write(w io.Writer, f *File) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "posit %d %d\n", f.Nbits, f.Es)
+	for _, v := range f.Vectors {
+		f.writeVector(bw, string(v.Op)+" ", v)
+	}
+	return bw.Flush()
+}
+
+// WriteTestFloat writes the vectors with no posit line and no operation names, like the
+// output of a TestFloat generator, every vector must be for the same operation.
+func WriteTestFloat(w io.Writer, f *File) error {
+	bw := bufio.NewWriter(w)
+	for i, v := range f.Vectors {
+		if v.Op != f.Vectors[0].Op {
+			return fmt.Errorf("vector %d is %v but vector 0 is %v", i, v.Op, f.Vectors[0].Op)
+		}
+		f.writeVector(bw, "", v)
+	}
+	return bw.Flush()
+}
+
+func (f *File) writeVector(w *bufio.Writer, prefix string, v Vector) {
+	w.WriteString(prefix)
+	for i, x := range v.Operands {
+		if i > 0 {
+			w.WriteByte(' ')
+		}
+		w.WriteString(f.hex(x))
+	}
+	w.WriteString(" " + f.hex(v.Result))
+	if v.Flags != FlagsUnknown {
+		fmt.Fprintf(w, " %02x", uint8(v.Flags))
+	}
+	w.WriteByte('\n')
+}
+
+// Impl is a posit implementation to run vectors against, Eval computes an operation on
+// operands given as bits and returns false if it does not support the operation.
+type Impl interface {
+	Nbits() uint
+	Es() uint
+	Eval(op Op, operands []uint64) (uint64, bool)
+}
+
+type slowImpl struct{ nbits, es uint }
+
+// Slow returns an Impl which uses SlowPosit
+func Slow(nbits, es uint) Impl {
+	goposit.NewSlowPosit(nbits, es)
+	return slowImpl{nbits, es}
+}
+
+func (s slowImpl) Nbits() uint { return s.nbits }
+func (s slowImpl) Es() uint    { return s.es }
+
+func (s slowImpl) posit(bits uint64) *goposit.SlowPosit {
+	p := goposit.NewSlowPosit(s.nbits, s.es)
+	p.SetBits(new(big.Int).SetUint64(bits))
+	return p
+}
+
+func (s slowImpl) Eval(op Op, x []uint64) (uint64, bool) {
+	switch op {
+	case Add:
+		return s.posit(x[0]).Add(s.posit(x[1])).Uint64(), true
+	case Sub:
+		return s.posit(x[0]).Sub(s.posit(x[1])).Uint64(), true
+	case Mul:
+		return s.posit(x[0]).Mul(s.posit(x[1])).Uint64(), true
+	case Div:
+		return s.posit(x[0]).Div(s.posit(x[1])).Uint64(), true
+	case Sqrt:
+		return s.posit(x[0]).Sqrt().Uint64(), true
+	}
+	return 0, false
+}
+
+// flags computes the exception flags of a result by comparing it to the exact value
+func (s slowImpl) flags(op Op, x []uint64, result uint64) Flags {
+	args := make([]*big.Rat, len(x))
+	for i, b := range x {
+		p := s.posit(b)
+		if p.IsNaR() {
+			return 0
+		}
+		args[i], _ = p.ToFloat().Rat(nil)
+	}
+	out := s.posit(result)
+	if out.IsNaR() {
+		return FlagInvalid
+	}
+	got, _ := out.ToFloat().Rat(nil)
+	var exact bool
+	switch op {
+	case Add:
+		exact = got.Cmp(new(big.Rat).Add(args[0], args[1])) == 0
+	case Sub:
+		exact = got.Cmp(new(big.Rat).Sub(args[0], args[1])) == 0
+	case Mul:
+		exact = got.Cmp(new(big.Rat).Mul(args[0], args[1])) == 0
+	case Div:
+		exact = args[1].Sign() != 0 && got.Cmp(new(big.Rat).Quo(args[0], args[1])) == 0
+	case Sqrt:
+		exact = new(big.Rat).Mul(got, got).Cmp(args[0]) == 0
+	}
+	if exact {
+		return 0
+	}
+	return FlagInexact
+}
+
+// Failure is a vector for which an implementation gave the wrong result or flags
+type Failure struct {
+	Vector
+	Got      uint64
+	GotFlags Flags
+}
+
+func (f Failure) String() string {
+	return fmt.Sprintf("line %d: %v %x: got %x flags %02x expected %x flags %02x",
+		f.Line, f.Op, f.Operands, f.Got, uint8(f.GotFlags), f.Result, uint8(f.Flags))
+}
+
+// Run evaluates every vector with impl and returns those which fail. The flags of the
+// result are worked out from its exact value so implementations only need to return bits.
+// An error is returned if impl is a different size or does not support an operation.
+func Run(f *File, impl Impl) ([]Failure, error) {
+	if impl.Nbits() != f.Nbits || impl.Es() != f.Es {
+		return nil, fmt.Errorf("%w: vectors are %d bit es %d, implementation is %d bit es %d",
+			ErrSize, f.Nbits, f.Es, impl.Nbits(), impl.Es())
+	}
+	s := slowImpl{f.Nbits, f.Es}
+	var out []Failure
+	for _, v := range f.Vectors {
+		got, ok := impl.Eval(v.Op, v.Operands)
+		if !ok {
+			return out, fmt.Errorf("implementation does not support %v", v.Op)
+		}
+		got &= f.mask()
+		flags := v.Flags
+		if flags != FlagsUnknown {
+			flags = s.flags(v.Op, v.Operands, got)
+		}
+		if got != v.Result || flags != v.Flags {
+			out = append(out, Failure{Vector: v, Got: got, GotFlags: flags})
+		}
+	}
+	return out, nil
+}
+
+// Append adds a vector for op with the result and flags computed by SlowPosit
+func (f *File) Append(op Op, operands ...uint64) {
+	if len(operands) != op.Arity() {
+		panic(fmt.Sprintf("%v needs %d operands", op, op.Arity()))
+	}
+	s := slowImpl{f.Nbits, f.Es}
+	x := make([]uint64, len(operands))
+	for i, b := range operands {
+		x[i] = b & f.mask()
+	}
+	result, _ := s.Eval(op, x)
+	f.Vectors = append(f.Vectors, Vector{Op: op, Operands: x, Result: result, Flags: s.flags(op, x, result)})
+}
+
+// Special returns the bit patterns of interesting posits: zero, NaR, one and its
+// neighbours, minpos, maxpos and the negatives of each.
+func Special(nbits uint) []uint64 {
+	mask := ^uint64(0) >> (64 - nbits)
+	nar := uint64(1) << (nbits - 1)
+	one := nar >> 1
+	out := []uint64{0, nar}
+	for _, b := range []uint64{1, 2, one - 1, one, one + 1, nar - 1} {
+		out = append(out, b, -b&mask)
+	}
+	return out
+}
+
+// Generate creates vectors for each operation, or for all of Ops if none are given. Every
+// combination of Special operands is included followed by n random ones, the same seed
+// always generates the same vectors.
+func Generate(nbits, es uint, n int, seed int64, ops ...Op) *File {
+	f := New(nbits, es)
+	if len(ops) == 0 {
+		ops = Ops
+	}
+	special := Special(nbits)
+	rng := rand.New(rand.NewSource(seed))
+	for _, op := range ops {
+		if op.Arity() == 1 {
+			for _, a := range special {
+				f.Append(op, a)
+			}
+		} else {
+			for _, a := range special {
+				for _, b := range special {
+					f.Append(op, a, b)
+				}
+			}
+		}
+		for i := 0; i < n; i++ {
+			x := make([]uint64, op.Arity())
+			for j := range x {
+				x[j] = rng.Uint64()
+			}
+			f.Append(op, x...)
+		}
+	}
+	return f
+}
@@ -0,0 +1,215 @@
+package testvectors_test
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cjdelisle/goposit/testvectors"
+)
+
+// go test -update regenerates the files in testdata from SlowPosit
+var update = flag.Bool("update", false, "regenerate the golden vectors in testdata")
+
+var golden = []struct {
+	file string
+	impl testvectors.Impl
+}{
+	{"posit8.txt", testvectors.Posit8()},
+	{"posit16.txt", testvectors.Posit16()},
+	{"posit32.txt", testvectors.Posit32()},
+	{"posit64.txt", testvectors.Posit64()},
+	{"posit12es2.txt", testvectors.Slow(12, 2)},
+}
+
+func TestGolden(t *testing.T) {
+	for _, g := range golden {
+		path := filepath.Join("testdata", g.file)
+		if *update {
+			var buf bytes.Buffer
+			f := testvectors.Generate(g.impl.Nbits(), g.impl.Es(), 100, 1)
+			if err := testvectors.Write(&buf, f); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := testvectors.Read(bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(f.Vectors) == 0 {
+			t.Errorf("%v: no vectors", path)
+		}
+		for _, impl := range []testvectors.Impl{g.impl, testvectors.Slow(f.Nbits, f.Es)} {
+			fails, err := testvectors.Run(f, impl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, fail := range fails {
+				if i == 10 {
+					t.Errorf("%v: %d more failures", path, len(fails)-i)
+					break
+				}
+				t.Errorf("%v: %v", path, fail)
+			}
+		}
+	}
+}
+
+// The files in testdata/testfloat are in the layout SoftPosit's test generators write but the
+// results come from the big.Rat reference in verify_test.go, so they only check the posit
+// types against that reference and not against SoftPosit. softposit.c in the same directory
+// rewrites them with results from SoftPosit.
+func TestTestFloatFiles(t *testing.T) {
+	impls := map[string]testvectors.Impl{
+		"p8":  testvectors.Posit8(),
+		"p16": testvectors.Posit16(),
+		"p32": testvectors.Posit32(),
+	}
+	paths, err := filepath.Glob(filepath.Join("testdata", "testfloat", "*.txt"))
+	if err != nil || len(paths) != 12 {
+		t.Fatalf("expected add, mul, div and sqrt for 3 sizes, found %v (%v)", paths, err)
+	}
+	for _, path := range paths {
+		size, name, _ := strings.Cut(strings.TrimSuffix(filepath.Base(path), ".txt"), "_")
+		impl := impls[size]
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := testvectors.ReadTestFloat(bytes.NewReader(data), impl.Nbits(), impl.Es(), testvectors.Op(name))
+		if err != nil {
+			t.Fatalf("%v: %v", path, err)
+		}
+		if len(f.Vectors) != 40 {
+			t.Errorf("%v: expected 40 vectors got %d", path, len(f.Vectors))
+		}
+		for _, impl := range []testvectors.Impl{impl, testvectors.Slow(f.Nbits, f.Es)} {
+			fails, err := testvectors.Run(f, impl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, fail := range fails {
+				t.Errorf("%v: %v", path, fail)
+			}
+		}
+	}
+}
+
+func TestKnownValues(t *testing.T) {
+	// hand checked Posit16 values: 1 + 1 = 2, 1 + 2 = 3, 1 / 3 rounds, 1 / 0 is NaR,
+	// sqrt(0.25) = 0.5, sqrt(2) rounds and 0.75 * 0.75 = 0.5625
+	in := `
+posit 16 1
+add 4000 4000 5000 00
+add 4000 5000 5800 00
+div 4000 5800 2555 01
+div 4000 0000 8000 10
+sqrt 2000 3000 00
+SQRT 5000 46a1 01   # names are not case sensitive
+op p16_mul          # TestFloat names have a size prefix
+5000 5000 6000 00
+3800 3800 3200
+`
+	f, err := testvectors.Read(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Vectors) != 8 || f.Vectors[7].Flags != testvectors.FlagsUnknown || f.Vectors[6].Op != testvectors.Mul {
+		t.Fatalf("unexpected vectors %+v", f.Vectors)
+	}
+	fails, err := testvectors.Run(f, testvectors.Posit16())
+	if err != nil || len(fails) != 0 {
+		t.Fatal(fails, err)
+	}
+
+	// a wrong result and wrong flags are both reported with the line
+	f.Vectors[0].Result = 0x5001
+	f.Vectors[1].Flags = testvectors.FlagInexact
+	fails, _ = testvectors.Run(f, testvectors.Posit16())
+	if len(fails) != 2 || fails[0].Line != 3 || fails[0].Got != 0x5000 || fails[1].GotFlags != 0 {
+		t.Errorf("unexpected failures %v", fails)
+	}
+	if _, err := testvectors.Run(f, testvectors.Posit32()); !errors.Is(err, testvectors.ErrSize) {
+		t.Errorf("expected ErrSize got %v", err)
+	}
+}
+
+func TestTestFloat(t *testing.T) {
+	f := testvectors.Generate(12, 1, 20, 2, testvectors.Add)
+	var buf bytes.Buffer
+	if err := testvectors.WriteTestFloat(&buf, f); err != nil {
+		t.Fatal(err)
+	}
+	// two operands, the result and flags with no posit line or operation name
+	if first, _, _ := strings.Cut(buf.String(), "\n"); len(strings.Fields(first)) != 4 {
+		t.Errorf("unexpected TestFloat line %q", first)
+	}
+	// "add" is a 12 bit hex number so it must be read as an operand here
+	buf.WriteString("add 000 add 00\n")
+	g, err := testvectors.ReadTestFloat(&buf, 12, 1, testvectors.Add)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := g.Vectors[len(g.Vectors)-1]
+	if len(g.Vectors) != len(f.Vectors)+1 || last.Operands[0] != 0xadd || last.Result != 0xadd {
+		t.Fatalf("unexpected vectors %+v", last)
+	}
+	if fails, err := testvectors.Run(g, testvectors.Slow(12, 1)); err != nil || len(fails) != 0 {
+		t.Fatal(fails, err)
+	}
+
+	f.Append(testvectors.Sqrt, 0x400)
+	if err := testvectors.WriteTestFloat(&buf, f); err == nil {
+		t.Error("mixed operations should not be written as TestFloat")
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	f := testvectors.Generate(6, 1, 10, 3)
+	var buf bytes.Buffer
+	if err := testvectors.Write(&buf, f); err != nil {
+		t.Fatal(err)
+	}
+	text := buf.String()
+	g, err := testvectors.Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Nbits != 6 || g.Es != 1 || len(g.Vectors) != len(f.Vectors) {
+		t.Fatalf("read %d vectors of %d bit es %d", len(g.Vectors), g.Nbits, g.Es)
+	}
+	buf.Reset()
+	testvectors.Write(&buf, g)
+	if buf.String() != text {
+		t.Error("writing the vectors again gave a different file")
+	}
+}
+
+func TestErrors(t *testing.T) {
+	for _, in := range []string{
+		"add 40 40 50",
+		"posit 8 0\nposit 8 0",
+		"posit 8 6",
+		"posit 8 0\nfma 40 40 40 50",
+		"posit 8 0\nadd 40 40",
+		"posit 8 0\nadd 40 140 50",
+		"posit 8 0\nadd 40 40 50 100",
+		"posit 8 0\nop nope",
+		"",
+	} {
+		if _, err := testvectors.Read(strings.NewReader(in)); !errors.Is(err, testvectors.ErrFormat) {
+			t.Errorf("%q: expected ErrFormat got %v", in, err)
+		}
+	}
+}
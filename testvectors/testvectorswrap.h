@@ -0,0 +1,25 @@
+
+type GLUE(impl, POSIT_T) struct{}
+
+__COMMENT__ POSIT_T returns an Impl which uses goposit.POSIT_T
+func POSIT_T() Impl { return GLUE(impl, POSIT_T){} }
+
+func (GLUE(impl, POSIT_T)) Nbits() uint { return NBITS }
+func (GLUE(impl, POSIT_T)) Es() uint    { return ES }
+
+func (GLUE(impl, POSIT_T)) Eval(op Op, x []uint64) (uint64, bool) {
+    p := goposit.GLUE(New, POSIT_T)()
+    switch op {
+    case Add:
+        return uint64(p.SetBits(UWORD(x[0])).Add(p.SetBits(UWORD(x[1]))).Bits()), true
+    case Sub:
+        return uint64(p.SetBits(UWORD(x[0])).Sub(p.SetBits(UWORD(x[1]))).Bits()), true
+    case Mul:
+        return uint64(p.SetBits(UWORD(x[0])).Mul(p.SetBits(UWORD(x[1]))).Bits()), true
+    case Div:
+        return uint64(p.SetBits(UWORD(x[0])).Div(p.SetBits(UWORD(x[1]))).Bits()), true
+    case Sqrt:
+        return uint64(p.SetBits(UWORD(x[0])).Sqrt().Bits()), true
+    }
+    return 0, false
+}
@@ -0,0 +1,97 @@
+package testvectors
+
+import (
+	"github.com/cjdelisle/goposit"
+)
+
+type implPosit8 struct{}
+
+// Posit8 returns an Impl which uses goposit.Posit8
+func Posit8() Impl             { return implPosit8{} }
+func (implPosit8) Nbits() uint { return 8 }
+func (implPosit8) Es() uint    { return 0 }
+func (implPosit8) Eval(op Op, x []uint64) (uint64, bool) {
+	p := goposit.NewPosit8()
+	switch op {
+	case Add:
+		return uint64(p.SetBits(uint8(x[0])).Add(p.SetBits(uint8(x[1]))).Bits()), true
+	case Sub:
+		return uint64(p.SetBits(uint8(x[0])).Sub(p.SetBits(uint8(x[1]))).Bits()), true
+	case Mul:
+		return uint64(p.SetBits(uint8(x[0])).Mul(p.SetBits(uint8(x[1]))).Bits()), true
+	case Div:
+		return uint64(p.SetBits(uint8(x[0])).Div(p.SetBits(uint8(x[1]))).Bits()), true
+	case Sqrt:
+		return uint64(p.SetBits(uint8(x[0])).Sqrt().Bits()), true
+	}
+	return 0, false
+}
+
+type implPosit16 struct{}
+
+// Posit16 returns an Impl which uses goposit.Posit16
+func Posit16() Impl             { return implPosit16{} }
+func (implPosit16) Nbits() uint { return 16 }
+func (implPosit16) Es() uint    { return 1 }
+func (implPosit16) Eval(op Op, x []uint64) (uint64, bool) {
+	p := goposit.NewPosit16()
+	switch op {
+	case Add:
+		return uint64(p.SetBits(uint16(x[0])).Add(p.SetBits(uint16(x[1]))).Bits()), true
+	case Sub:
+		return uint64(p.SetBits(uint16(x[0])).Sub(p.SetBits(uint16(x[1]))).Bits()), true
+	case Mul:
+		return uint64(p.SetBits(uint16(x[0])).Mul(p.SetBits(uint16(x[1]))).Bits()), true
+	case Div:
+		return uint64(p.SetBits(uint16(x[0])).Div(p.SetBits(uint16(x[1]))).Bits()), true
+	case Sqrt:
+		return uint64(p.SetBits(uint16(x[0])).Sqrt().Bits()), true
+	}
+	return 0, false
+}
+
+type implPosit32 struct{}
+
+// Posit32 returns an Impl which uses goposit.Posit32
+func Posit32() Impl             { return implPosit32{} }
+func (implPosit32) Nbits() uint { return 32 }
+func (implPosit32) Es() uint    { return 2 }
+func (implPosit32) Eval(op Op, x []uint64) (uint64, bool) {
+	p := goposit.NewPosit32()
+	switch op {
+	case Add:
+		return uint64(p.SetBits(uint32(x[0])).Add(p.SetBits(uint32(x[1]))).Bits()), true
+	case Sub:
+		return uint64(p.SetBits(uint32(x[0])).Sub(p.SetBits(uint32(x[1]))).Bits()), true
+	case Mul:
+		return uint64(p.SetBits(uint32(x[0])).Mul(p.SetBits(uint32(x[1]))).Bits()), true
+	case Div:
+		return uint64(p.SetBits(uint32(x[0])).Div(p.SetBits(uint32(x[1]))).Bits()), true
+	case Sqrt:
+		return uint64(p.SetBits(uint32(x[0])).Sqrt().Bits()), true
+	}
+	return 0, false
+}
+
+type implPosit64 struct{}
+
+// Posit64 returns an Impl which uses goposit.Posit64
+func Posit64() Impl             { return implPosit64{} }
+func (implPosit64) Nbits() uint { return 64 }
+func (implPosit64) Es() uint    { return 3 }
+func (implPosit64) Eval(op Op, x []uint64) (uint64, bool) {
+	p := goposit.NewPosit64()
+	switch op {
+	case Add:
+		return uint64(p.SetBits(uint64(x[0])).Add(p.SetBits(uint64(x[1]))).Bits()), true
+	case Sub:
+		return uint64(p.SetBits(uint64(x[0])).Sub(p.SetBits(uint64(x[1]))).Bits()), true
+	case Mul:
+		return uint64(p.SetBits(uint64(x[0])).Mul(p.SetBits(uint64(x[1]))).Bits()), true
+	case Div:
+		return uint64(p.SetBits(uint64(x[0])).Div(p.SetBits(uint64(x[1]))).Bits()), true
+	case Sqrt:
+		return uint64(p.SetBits(uint64(x[0])).Sqrt().Bits()), true
+	}
+	return 0, false
+}
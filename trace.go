@@ -0,0 +1,396 @@
+package goposit
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// DefaultTracePrec is the precision of shadow values when Tracer.Prec is zero
+const DefaultTracePrec = 256
+
+// DefaultCancelBits is the number of bits which must cancel in an addition or subtraction
+// for it to be counted as a cancellation when Tracer.CancelBits is zero
+const DefaultCancelBits = 8
+
+// Tracer follows a computation on SlowTraced values, every value carries a shadow which
+// is the same computation done in high precision, and every operation is recorded against
+// the place in the source which called it. The result shows which operations lost the
+// accuracy, see Worst and WriteReport.
+//
+// Tracing is slow and a Tracer is not safe for concurrent use.
+type Tracer struct {
+	// Prec is the precision of shadow values in bits
+	Prec uint
+
+	// CancelBits is the number of leading bits which must cancel in Add or Sub to record
+	// a cancellation
+	CancelBits int
+
+	label string
+	sites map[siteKey]*TraceSite
+	order []*TraceSite
+}
+
+// NewTracer creates a Tracer with the default precision
+func NewTracer() *Tracer {
+	return &Tracer{sites: map[siteKey]*TraceSite{}}
+}
+
+// SetLabel attaches a label to every operation which follows, so that phases of a
+// computation can be told apart in the report, an empty label removes it.
+func (t *Tracer) SetLabel(label string) { t.label = label }
+
+// Reset forgets every recorded operation
+func (t *Tracer) Reset() {
+	t.sites = map[siteKey]*TraceSite{}
+	t.order = nil
+}
+
+func (t *Tracer) prec() uint {
+	if t.Prec == 0 {
+		return DefaultTracePrec
+	}
+	return t.Prec
+}
+
+func (t *Tracer) cancelBits() int {
+	if t.CancelBits == 0 {
+		return DefaultCancelBits
+	}
+	return t.CancelBits
+}
+
+// SlowTraced is a SlowPosit with a high precision shadow of the value it would have if
+// every operation leading to it was done without rounding, a nil Shadow means the true
+// value is undefined, for example the square root of a negative number.
+type SlowTraced struct {
+	Value  *SlowPosit
+	Shadow *big.Float
+	t      *Tracer
+}
+
+// Trace starts tracing p, its shadow is its exact value
+func (t *Tracer) Trace(p *SlowPosit) *SlowTraced {
+	if p.IsNaR() {
+		return &SlowTraced{Value: p, t: t}
+	}
+	return &SlowTraced{Value: p, Shadow: p.ToFloat(), t: t}
+}
+
+// TraceFloat rounds f to a posit and starts tracing it with f as the shadow, the rounding
+// is recorded as an operation named "input".
+func (t *Tracer) TraceFloat(nbits, es uint, f *big.Float) *SlowTraced {
+	p := NewSlowPosit(nbits, es)
+	shadow := new(big.Float).SetPrec(t.prec()).Set(f)
+	if f.IsInf() {
+		shadow = nil
+	}
+	p.FromFloat(f, false)
+	if shadow == nil {
+		p.NaR()
+	}
+	out := &SlowTraced{Value: p, Shadow: shadow, t: t}
+	t.record("input", out, out.Err(), f, 0)
+	return out
+}
+
+// Err returns the relative error of the value compared to its shadow, zero if both are
+// NaR and +Inf if only one of them is.
+func (v *SlowTraced) Err() float64 {
+	return relErr(v.Value, v.Shadow)
+}
+
+// relErr is |p - f| / |f|, with NaR and a nil f meaning undefined
+func relErr(p *SlowPosit, f *big.Float) float64 {
+	switch {
+	case p.IsNaR() || f == nil:
+		if p.IsNaR() && f == nil {
+			return 0
+		}
+		return math.Inf(1)
+	case f.Sign() == 0:
+		if p.isZero() {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	d := new(big.Float).SetPrec(f.Prec()).Sub(p.ToFloat(), f)
+	e, _ := d.Quo(d, f).Float64()
+	return math.Abs(e)
+}
+
+// traceOp is an operation on shadows and on posits, y is nil for a unary operation
+type traceOp struct {
+	name   string
+	posit  func(x, y *SlowPosit) *SlowPosit
+	shadow func(prec uint, x, y *big.Float) *big.Float
+}
+
+var (
+	traceAdd = traceOp{"add", (*SlowPosit).Add, func(prec uint, x, y *big.Float) *big.Float {
+		return new(big.Float).SetPrec(prec).Add(x, y)
+	}}
+	traceSub = traceOp{"sub", (*SlowPosit).Sub, func(prec uint, x, y *big.Float) *big.Float {
+		return new(big.Float).SetPrec(prec).Sub(x, y)
+	}}
+	traceMul = traceOp{"mul", (*SlowPosit).Mul, func(prec uint, x, y *big.Float) *big.Float {
+		return new(big.Float).SetPrec(prec).Mul(x, y)
+	}}
+	traceDiv = traceOp{"div", (*SlowPosit).Div, func(prec uint, x, y *big.Float) *big.Float {
+		if y.Sign() == 0 {
+			return nil
+		}
+		return new(big.Float).SetPrec(prec).Quo(x, y)
+	}}
+	traceSqrt = traceOp{"sqrt", func(x, _ *SlowPosit) *SlowPosit { return x.Sqrt() },
+		func(prec uint, x, _ *big.Float) *big.Float {
+			if x.Sign() < 0 {
+				return nil
+			}
+			return new(big.Float).SetPrec(prec).Sqrt(x)
+		}}
+)
+
+// apply does the operation on the values and on the shadows and records it
+func (v *SlowTraced) apply(op traceOp, x *SlowTraced) *SlowTraced {
+	var xv, local *SlowPosit
+	var xs *big.Float
+	if x != nil {
+		assertCompat(v.Value, x.Value)
+		xv, xs = x.Value, x.Shadow
+	}
+	out := &SlowTraced{Value: op.posit(v.Value, xv), t: v.t}
+	if v.Shadow != nil && (x == nil || xs != nil) {
+		out.Shadow = op.shadow(v.t.prec(), v.Shadow, xs)
+	}
+
+	// the local error is what this operation alone does to correctly rounded inputs
+	if out.Shadow != nil {
+		in := outPosit(v.Value, v.Shadow)
+		var xin *SlowPosit
+		if x != nil {
+			xin = outPosit(v.Value, xs)
+		}
+		local = op.posit(in, xin)
+	} else {
+		local = v.Value.Clone().NaR()
+	}
+	exact := op.exact(v.Value, xv)
+	cancelled := 0
+	if op.name == "add" || op.name == "sub" {
+		cancelled = cancelledBits(v.Value, xv, exact)
+	}
+	v.t.record(op.name, out, relErr(local, out.Shadow), exact, cancelled)
+	return out
+}
+
+// exact is the result of the operation on the posit values with enough precision to
+// tell whether it is beyond maxpos or minpos, nil if it is undefined
+func (op traceOp) exact(x, y *SlowPosit) *big.Float {
+	if x.IsNaR() || (y != nil && y.IsNaR()) {
+		return nil
+	}
+	var yf *big.Float
+	if y != nil {
+		yf = y.ToFloat()
+	}
+	return op.shadow(4*x.nbits, x.ToFloat(), yf)
+}
+
+// Add returns v + x
+func (v *SlowTraced) Add(x *SlowTraced) *SlowTraced { return v.apply(traceAdd, x) }
+
+// Sub returns v - x
+func (v *SlowTraced) Sub(x *SlowTraced) *SlowTraced { return v.apply(traceSub, x) }
+
+// Mul returns v * x
+func (v *SlowTraced) Mul(x *SlowTraced) *SlowTraced { return v.apply(traceMul, x) }
+
+// Div returns v / x
+func (v *SlowTraced) Div(x *SlowTraced) *SlowTraced { return v.apply(traceDiv, x) }
+
+// Sqrt returns the square root of v
+func (v *SlowTraced) Sqrt() *SlowTraced { return v.apply(traceSqrt, nil) }
+
+// Neg returns -v, negation is exact and is not recorded
+func (v *SlowTraced) Neg() *SlowTraced {
+	out := &SlowTraced{Value: v.Value.neg(), t: v.t}
+	if v.Shadow != nil {
+		out.Shadow = new(big.Float).Neg(v.Shadow)
+	}
+	return out
+}
+
+// TraceEvent is one recorded operation
+type TraceEvent struct {
+	Op     string
+	Result *SlowPosit
+	Shadow *big.Float
+
+	// Err is the relative error of the result compared to its shadow, which includes the
+	// error carried in by the operands
+	Err float64
+
+	// LocalErr is the relative error which the operation causes when its operands are
+	// the correctly rounded shadows, this is large where accuracy is lost even if every
+	// earlier operation was accurate, for example when nearly equal values are subtracted.
+	LocalErr float64
+
+	// CancelledBits is the number of leading bits which cancelled in an Add or Sub
+	CancelledBits int
+
+	// Overflow is true if the result was saturated to maxpos and Underflow is true if it
+	// was rounded up to minpos
+	Overflow  bool
+	Underflow bool
+}
+
+// TraceSite is the summary of the operations done by one line of source code with one
+// label
+type TraceSite struct {
+	// Site is the file and line which called the operation
+	Site  string
+	Label string
+	Op    string
+
+	Count         int
+	Cancellations int
+	Overflows     int
+	Underflows    int
+
+	MaxErr      float64
+	MaxLocalErr float64
+	SumLocalErr float64
+
+	// Worst is the operation with the largest local error
+	Worst TraceEvent
+}
+
+// MeanLocalErr is the average local error of the operations at this site
+func (s *TraceSite) MeanLocalErr() float64 { return s.SumLocalErr / float64(s.Count) }
+
+type siteKey struct{ site, label, op string }
+
+var tracePkg = func() string {
+	pc, _, _, _ := runtime.Caller(0)
+	name := runtime.FuncForPC(pc).Name()
+	slash := strings.LastIndex(name, "/")
+	return name[:slash+strings.Index(name[slash:], ".")+1]
+}()
+
+// callSite returns the file and line of the first caller outside of this package
+func callSite() string {
+	pcs := make([]uintptr, 16)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		f, more := frames.Next()
+		if !strings.HasPrefix(f.Function, tracePkg) || !more {
+			return fmt.Sprintf("%s:%d", filepath.Base(f.File), f.Line)
+		}
+	}
+}
+
+// record adds an operation to its site, exact is the result before rounding to the posit
+// and cancelled is the number of leading bits which cancelled
+func (t *Tracer) record(op string, out *SlowTraced, local float64, exact *big.Float, cancelled int) {
+	if t.sites == nil {
+		t.sites = map[siteKey]*TraceSite{}
+	}
+	key := siteKey{callSite(), t.label, op}
+	s := t.sites[key]
+	if s == nil {
+		s = &TraceSite{Site: key.site, Label: key.label, Op: op}
+		t.sites[key] = s
+		t.order = append(t.order, s)
+	}
+	ev := TraceEvent{Op: op, Result: out.Value, Shadow: out.Shadow, Err: out.Err(), LocalErr: local,
+		CancelledBits: cancelled}
+	if exact != nil && exact.Sign() != 0 && !out.Value.IsNaR() {
+		mag := new(big.Float).Abs(exact)
+		max := out.Value.Clone().Max().ToFloat()
+		min := out.Value.Clone().Min().ToFloat()
+		ev.Overflow = mag.Cmp(max) > 0
+		ev.Underflow = mag.Cmp(min) < 0
+	}
+	s.Count++
+	if ev.Overflow {
+		s.Overflows++
+	}
+	if ev.Underflow {
+		s.Underflows++
+	}
+	if cancelled >= t.cancelBits() {
+		s.Cancellations++
+	}
+	s.MaxErr = math.Max(s.MaxErr, ev.Err)
+	s.SumLocalErr += local
+	if local > s.MaxLocalErr || s.Count == 1 {
+		s.MaxLocalErr = local
+		s.Worst = ev
+	}
+}
+
+// cancelledBits is the number of leading bits of the larger of x and y which are lost in
+// their sum, all of them if the sum is zero
+func cancelledBits(x, y *SlowPosit, sum *big.Float) int {
+	if sum == nil || x.isZero() || y.isZero() {
+		return 0
+	}
+	if sum.Sign() == 0 {
+		return int(x.nbits)
+	}
+	top := x.ToFloat().MantExp(nil)
+	if e := y.ToFloat().MantExp(nil); e > top {
+		top = e
+	}
+	if bits := top - sum.MantExp(nil); bits > 0 {
+		return bits
+	}
+	return 0
+}
+
+// Sites returns the summary of every site in the order they were first seen
+func (t *Tracer) Sites() []*TraceSite {
+	return append([]*TraceSite(nil), t.order...)
+}
+
+// Worst returns up to n sites with the largest local error, ties are broken by the
+// largest error of the result.
+func (t *Tracer) Worst(n int) []*TraceSite {
+	out := t.Sites()
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].MaxLocalErr != out[j].MaxLocalErr {
+			return out[i].MaxLocalErr > out[j].MaxLocalErr
+		}
+		return out[i].MaxErr > out[j].MaxErr
+	})
+	if n >= 0 && n < len(out) {
+		out = out[:n]
+	}
+	return out
+}
+
+// WriteReport writes a table of the n worst sites, n less than zero means every site
+func (t *Tracer) WriteReport(w io.Writer, n int) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "site\tlabel\top\tcount\tmax local err\tmean local err\tmax err\tcancel\toverflow\tunderflow\tworst")
+	for _, s := range t.Worst(n) {
+		worst := "NaR"
+		if !s.Worst.Result.IsNaR() {
+			worst = s.Worst.Result.ToFloat().Text('g', 10)
+		}
+		if s.Worst.Shadow != nil {
+			worst += " (exact " + s.Worst.Shadow.Text('g', 10) + ")"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%.3g\t%.3g\t%.3g\t%d\t%d\t%d\t%s\n", s.Site, s.Label, s.Op, s.Count,
+			s.MaxLocalErr, s.MeanLocalErr(), s.MaxErr, s.Cancellations, s.Overflows, s.Underflows, worst)
+	}
+	return tw.Flush()
+}
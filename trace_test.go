@@ -0,0 +1,103 @@
+package goposit_test
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+func TestTraceCancellation(t *testing.T) {
+	tr := goposit.NewTracer()
+	// 1.0001 rounds to 1 in Posit16 so the subtraction loses everything
+	x := goposit.NewPosit16TracedFloat(tr, big.NewFloat(1.0001))
+	one := goposit.NewPosit16Traced(tr, goposit.Posit16One())
+	tr.SetLabel("difference")
+	d := x.Sub(one)
+	tr.SetLabel("")
+	scaled := d.Mul(goposit.NewPosit16Traced(tr, p16(2)))
+
+	if d.Value().Bits() != 0 || d.Err() != 1 || scaled.Err() != 1 {
+		t.Errorf("expected a zero result with relative error 1, got %x %v", d.Value().Bits(), d.Err())
+	}
+	if f, _ := d.Shadow().Float64(); math.Abs(f-1e-4) > 1e-12 {
+		t.Errorf("shadow should be 1e-4 got %v", f)
+	}
+
+	worst := tr.Worst(1)[0]
+	if worst.Op != "sub" || worst.Label != "difference" || worst.Cancellations != 1 || worst.MaxLocalErr != 1 ||
+		!strings.HasPrefix(worst.Site, "trace_test.go:") {
+		t.Errorf("the subtraction should be the worst site, got %+v", *worst)
+	}
+	for _, s := range tr.Sites() {
+		// on correctly rounded inputs the multiplication only rounds its result
+		if s.Op == "mul" && (s.MaxLocalErr > 1.0/512 || s.MaxErr != 1) {
+			t.Errorf("mul should inherit the error without adding to it, got %+v", *s)
+		}
+		if s.Op == "input" && math.Abs(s.MaxErr-1e-4/1.0001) > 1e-12 {
+			t.Errorf("input rounding error should be recorded, got %+v", *s)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tr.WriteReport(&buf, 2); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "site") || !strings.Contains(lines[1], "difference") {
+		t.Errorf("unexpected report:\n%s", buf.String())
+	}
+
+	tr.Reset()
+	if len(tr.Sites()) != 0 {
+		t.Error("Reset should forget every site")
+	}
+}
+
+func TestTraceOverflow(t *testing.T) {
+	tr := goposit.NewTracer()
+	x32 := goposit.NewSlowPosit(8, 0)
+	x32.FromFloat(big.NewFloat(32), false)
+	x := tr.Trace(x32)
+	for i := 0; i < 3; i++ {
+		x = x.Mul(x)
+	}
+	// 32**8 saturates to maxpos 64 at the first multiplication and then stays there
+	if f, _ := x.Value.ToFloat().Float64(); f != 64 {
+		t.Errorf("expected maxpos got %v", f)
+	}
+	s := tr.Sites()[0]
+	if s.Count != 3 || s.Overflows != 3 || s.Underflows != 0 {
+		t.Errorf("unexpected overflow count %+v", *s)
+	}
+
+	small := tr.Trace(goposit.NewSlowPosit(8, 0).Min())
+	if small.Mul(small).Value.ToFloat().Cmp(small.Value.ToFloat()) != 0 || tr.Sites()[1].Underflows != 1 {
+		t.Errorf("minpos squared should underflow to minpos, got %+v", *tr.Sites()[1])
+	}
+}
+
+func TestTraceUndefined(t *testing.T) {
+	tr := goposit.NewTracer()
+	neg := goposit.NewPosit32Traced(tr, goposit.NewPosit32().SetBits(0).Sub(goposit.Posit32One()))
+	r := neg.Sqrt()
+	if r.Value().Bits() != goposit.Posit32NaR().Bits() || r.Shadow() != nil || r.Err() != 0 {
+		t.Errorf("sqrt(-1) should be NaR with no shadow, got %v %v", r.Shadow(), r.Err())
+	}
+	z := goposit.NewPosit32Traced(tr, goposit.NewPosit32())
+	if q := neg.Div(z); q.Value().Bits() != goposit.Posit32NaR().Bits() || q.Shadow() != nil {
+		t.Error("division by zero should be undefined")
+	}
+	if n := neg.Neg(); n.Value().Bits() != goposit.Posit32One().Bits() || n.Shadow().Cmp(big.NewFloat(1)) != 0 {
+		t.Error("Neg should negate both the value and the shadow")
+	}
+
+	// a posit which is accurate but whose shadow differs is still an error
+	sum := neg.Add(goposit.NewPosit32TracedFloat(tr, big.NewFloat(1e-20)))
+	if sum.Value().Bits() != neg.Value().Bits() || sum.Err() == 0 || sum.Err() > 1e-19 {
+		t.Errorf("unexpected error %v", sum.Err())
+	}
+}
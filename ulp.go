@@ -0,0 +1,80 @@
+package goposit
+
+import (
+	"math/big"
+)
+
+// posits are ordered the same way as their bits are when taken as two's complement
+// integers, signed returns that integer.
+func (p *SlowPosit) signed() *big.Int {
+	out := new(big.Int).Set(p.Bits)
+	if out.Bit(p.signBitIdx()) == 1 {
+		out.Sub(out, new(big.Int).Lsh(bigi1, p.nbits))
+	}
+	return out
+}
+
+// step returns the next posit up (dir = 1) or down (dir = -1), stepping past the largest
+// or smallest posit reaches NaR.
+func (p *SlowPosit) step(dir int64) *SlowPosit {
+	mod := new(big.Int).Lsh(bigi1, p.nbits)
+	b := new(big.Int).Add(p.Bits, big.NewInt(dir))
+	b.Mod(b, mod)
+	return &SlowPosit{nbits: p.nbits, es: p.es, Bits: b}
+}
+
+// NextUp returns the smallest posit which is greater than p, the next posit after
+// the largest posit is NaR and the next posit after NaR is also NaR.
+func (p *SlowPosit) NextUp() *SlowPosit {
+	if p.IsNaR() {
+		return p.Clone()
+	}
+	return p.step(1)
+}
+
+// NextDown returns the largest posit which is less than p, the next posit before
+// the most negative posit is NaR and the next posit before NaR is also NaR.
+func (p *SlowPosit) NextDown() *SlowPosit {
+	if p.IsNaR() {
+		return p.Clone()
+	}
+	return p.step(-1)
+}
+
+// ULPFloat returns the unit in the last place of p, that is the distance from |p| to the
+// next posit further from zero, or for the largest posit, the distance to the next
+// posit closer to zero. The ULP of zero is the smallest posit and the ULP of NaR is Inf.
+func (p *SlowPosit) ULPFloat() *big.Float {
+	if p.IsNaR() {
+		return new(big.Float).SetInf(false)
+	}
+	abs := p
+	if p.Bits.Bit(p.signBitIdx()) == 1 {
+		abs = p.neg()
+	}
+	next := abs.step(1)
+	if next.IsNaR() {
+		next = abs
+		abs = abs.step(-1)
+	}
+	nf := next.ToFloat()
+	af := abs.ToFloat()
+	return exactAdd(nf, af.Neg(af))
+}
+
+// ULP returns the unit in the last place of p as a posit, see ULPFloat. If the ULP is
+// smaller than the smallest posit, the smallest posit is returned.
+func (p *SlowPosit) ULP() *SlowPosit {
+	return outPosit(p, p.ULPFloat())
+}
+
+// Distance returns the number of steps from p to x, for example the distance between a
+// posit and p.NextUp() is 1. The distance to NaR is not defined and will panic.
+func (p *SlowPosit) Distance(x *SlowPosit) *big.Int {
+	assertCompat(p, x)
+	if p.IsNaR() || x.IsNaR() {
+		panic("distance to NaR is not defined")
+	}
+	d := new(big.Int).Sub(x.signed(), p.signed())
+	return d.Abs(d)
+}
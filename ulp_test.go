@@ -0,0 +1,52 @@
+package goposit_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+func TestNextULP(t *testing.T) {
+	p := goposit.NewPosit8()
+	one := p.FromInt(1)
+	if one.NextUp().Bits() != 0x41 || one.NextDown().Bits() != 0x3f {
+		t.Errorf("NextUp/NextDown of 1 are wrong")
+	}
+	if p.SetBits(0x7f).NextUp().Bits() != 0x80 || p.SetBits(0x80).NextUp().Bits() != 0x80 {
+		t.Errorf("NextUp of maxpos and NaR should be NaR")
+	}
+	if p.SetBits(0x81).NextDown().Bits() != 0x80 || p.SetBits(0x80).NextDown().Bits() != 0x80 {
+		t.Errorf("NextDown of -maxpos and NaR should be NaR")
+	}
+	if p.SetBits(0).NextDown().Bits() != 0xff || p.SetBits(0).NextUp().Bits() != 0x01 {
+		t.Errorf("NextUp/NextDown of zero should be minpos/-minpos")
+	}
+
+	// 1 has 5 fraction bits in a Posit8
+	if one.ULPFloat().Cmp(big.NewFloat(1.0/32)) != 0 || one.ULP().Bits() != p.SetBits(0x40).ExpAdd(-5).Bits() {
+		t.Errorf("ULP of 1 should be 1/32, got %v", one.ULPFloat())
+	}
+	// 1 - 1/64 is just below 1 and the gap to 1 is smaller
+	if p.SetBits(0x3f).ULPFloat().Cmp(big.NewFloat(1.0/64)) != 0 {
+		t.Errorf("ULP of 0x3f should be 1/64, got %v", p.SetBits(0x3f).ULPFloat())
+	}
+	// the ULP is symmetric around zero
+	if p.FromInt(-1).ULPFloat().Cmp(one.ULPFloat()) != 0 {
+		t.Errorf("ULP of -1 should be the same as 1")
+	}
+	// maxpos uses the gap below it, 64 - 32
+	if p.SetBits(0x7f).ULPFloat().Cmp(big.NewFloat(32)) != 0 {
+		t.Errorf("ULP of maxpos should be 32, got %v", p.SetBits(0x7f).ULPFloat())
+	}
+	if p.SetBits(0).ULP().Bits() != 0x01 {
+		t.Errorf("ULP of zero should be minpos")
+	}
+
+	if one.Distance(one.NextUp()) != 1 || one.Distance(p.FromInt(-1)) != 128 {
+		t.Errorf("Distance is wrong")
+	}
+	if d := goposit.NewPosit64().SetBits(0x8000000000000001).Distance(goposit.NewPosit64().SetBits(0x7fffffffffffffff)); d != 0xfffffffffffffffe {
+		t.Errorf("Distance between -maxpos and maxpos should be 2**64-2, got %x", d)
+	}
+}
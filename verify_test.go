@@ -0,0 +1,413 @@
+package goposit_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/cjdelisle/goposit"
+)
+
+// The reference below is written only with big.Rat and big.Int, it does not use any of the
+// code under test. Rounding follows the posit standard: the exact value is written out as
+// an infinitely long posit bit string which is then rounded to nearest even, so the tie
+// between two posits whose exponent bits have been cut off is the geometric midpoint. No
+// value rounds to zero or NaR, too large values give maxpos and too small values minpos.
+
+type ref struct{ nbits, es uint }
+
+var ref8 = ref{8, 0}
+var ref16 = ref{16, 1}
+var ref32 = ref{32, 2}
+
+func (r ref) nar() uint64  { return 1 << (r.nbits - 1) }
+func (r ref) mask() uint64 { return 1<<r.nbits - 1 }
+
+// value decodes bits to an exact rational, it returns nil for NaR
+func (r ref) value(bits uint64) *big.Rat {
+	if bits == 0 {
+		return new(big.Rat)
+	}
+	if bits == r.nar() {
+		return nil
+	}
+	neg := bits&r.nar() != 0
+	if neg {
+		bits = -bits & r.mask()
+	}
+	// walk the body from the bit after the sign
+	idx := int(r.nbits) - 2
+	first := bits >> uint(idx) & 1
+	run := 0
+	for idx >= 0 && bits>>uint(idx)&1 == first {
+		run++
+		idx--
+	}
+	if idx >= 0 {
+		idx-- // the terminating bit
+	}
+	k := -run
+	if first == 1 {
+		k = run - 1
+	}
+	e := 0
+	for i := 0; i < int(r.es); i++ {
+		e <<= 1
+		if idx >= 0 {
+			e |= int(bits >> uint(idx) & 1)
+			idx--
+		}
+	}
+	fbits := idx + 1
+	frac := bits & (1<<uint(fbits) - 1)
+	// (1 + frac / 2**fbits) * 2**(k * 2**es + e)
+	v := new(big.Rat).SetFrac(new(big.Int).SetUint64(1<<uint(fbits)+frac), new(big.Int).Lsh(big.NewInt(1), uint(fbits)))
+	v.Mul(v, pow2(k*(1<<r.es)+e))
+	if neg {
+		v.Neg(v)
+	}
+	return v
+}
+
+func pow2(s int) *big.Rat {
+	if s >= 0 {
+		return new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), uint(s)))
+	}
+	return new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), uint(-s)))
+}
+
+// log2 returns floor(log2(a)) for a positive rational
+func log2(a *big.Rat) int {
+	s := a.Num().BitLen() - a.Denom().BitLen()
+	if a.Cmp(pow2(s)) < 0 {
+		s--
+	}
+	return s
+}
+
+// floorDiv divides rounding toward negative infinity
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// round converts v to the nearest posit, if sticky is set then v is known to be slightly
+// larger in magnitude than it is, which is used for irrational results.
+func (r ref) round(v *big.Rat, sticky bool) uint64 {
+	if v.Sign() == 0 && !sticky {
+		return 0
+	}
+	a := new(big.Rat).Abs(v)
+	s := log2(a)
+	k := floorDiv(s, 1<<r.es)
+	e := s - k*(1<<r.es)
+
+	// regime, exponent and then enough fraction bits that the rest only matter as sticky
+	stream := new(big.Int)
+	n := 0
+	push := func(bit uint) {
+		stream.Lsh(stream, 1)
+		stream.SetBit(stream, 0, bit)
+		n++
+	}
+	if k >= 0 {
+		for i := 0; i <= k; i++ {
+			push(1)
+		}
+		push(0)
+	} else {
+		for i := 0; i < -k; i++ {
+			push(0)
+		}
+		push(1)
+	}
+	for i := int(r.es) - 1; i >= 0; i-- {
+		push(uint(e >> uint(i) & 1))
+	}
+	fracBits := int(r.nbits) + 2
+	f := new(big.Rat).Mul(a, pow2(-s))
+	f.Sub(f, big.NewRat(1, 1))
+	f.Mul(f, pow2(fracBits))
+	fi := new(big.Int).Quo(f.Num(), f.Denom())
+	if new(big.Rat).SetInt(fi).Cmp(f) != 0 {
+		sticky = true
+	}
+	stream.Lsh(stream, uint(fracBits))
+	stream.Or(stream, fi)
+	n += fracBits
+
+	// body is the first nbits-1 bits, then the guard bit, then the sticky bits
+	bodyBits := int(r.nbits) - 1
+	rest := n - bodyBits
+	body := new(big.Int).Rsh(stream, uint(rest)).Uint64()
+	guard := stream.Bit(rest - 1)
+	low := new(big.Int).And(stream, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(rest-1)), big.NewInt(1)))
+	if low.Sign() != 0 {
+		sticky = true
+	}
+	if guard == 1 && (sticky || body&1 == 1) {
+		body++
+	}
+	if body >= 1<<uint(bodyBits) {
+		body = 1<<uint(bodyBits) - 1
+	}
+	if body == 0 {
+		body = 1
+	}
+	if v.Sign() < 0 {
+		body = -body & r.mask()
+	}
+	return body
+}
+
+// floor returns the largest posit which is less than or equal to v, below the most
+// negative posit it is the most negative posit.
+func (r ref) floor(v *big.Rat) uint64 {
+	out := r.round(v, false)
+	if r.value(out).Cmp(v) > 0 {
+		out = (out - 1) & r.mask()
+		if out == r.nar() {
+			out++
+		}
+	}
+	return out
+}
+
+// sqrt returns the square root of a non-negative rational rounded to nearest even
+func (r ref) sqrt(v *big.Rat) uint64 {
+	// sqrt(n/d) = sqrt(n*d*4**m) / (d*2**m) for m large enough to be far past the last bit
+	const m = 400
+	x := new(big.Int).Mul(v.Num(), v.Denom())
+	x.Lsh(x, 2*m)
+	s := new(big.Int).Sqrt(x)
+	exact := new(big.Int).Mul(s, s).Cmp(x) == 0
+	q := new(big.Rat).SetFrac(s, new(big.Int).Lsh(v.Denom(), m))
+	return r.round(q, !exact)
+}
+
+// roundInt rounds to the nearest integer, ties to even
+func roundInt(v *big.Rat) *big.Int {
+	q, m := new(big.Int).DivMod(v.Num(), v.Denom(), new(big.Int))
+	// v = q + m/den with 0 <= m < den
+	c := new(big.Int).Lsh(m, 1).Cmp(v.Denom())
+	if c > 0 || (c == 0 && q.Bit(0) == 1) {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
+
+// describe shows a posit with its decoded fields for error messages
+func describe(nbits, es uint, bits uint64) string {
+	p := goposit.NewSlowPosit(nbits, es)
+	p.SetBits(new(big.Int).SetUint64(bits))
+	f := p.Decode()
+	switch {
+	case f.NaR:
+		return fmt.Sprintf("%#x (NaR)", bits)
+	case f.Zero:
+		return fmt.Sprintf("%#x (zero)", bits)
+	}
+	sign := "+"
+	if f.Sign {
+		sign = "-"
+	}
+	return fmt.Sprintf("%#x (%s k=%d e=%d f=%0*b scale=%d)", bits, sign, f.RegimeK, f.ExponentBits,
+		f.FractionBits, f.Fraction, f.Scale)
+}
+
+type checker struct {
+	t      *testing.T
+	failed int
+}
+
+// check reports a mismatch, giving up on an operation after a few so the output is readable
+func (c *checker) check(op string, r ref, got, expect uint64, inputs ...uint64) {
+	if got == expect {
+		return
+	}
+	c.failed++
+	if c.failed > 20 {
+		if c.failed == 21 {
+			c.t.Errorf("too many mismatches, giving up")
+		}
+		return
+	}
+	in := ""
+	for _, x := range inputs {
+		in += " " + describe(r.nbits, r.es, x)
+	}
+	c.t.Errorf("%v%v: got %v expected %v", op, in, describe(r.nbits, r.es, got), describe(r.nbits, r.es, expect))
+}
+
+// checkBinary8 checks every binary operation on a pair of Posit8
+func checkBinary8(c *checker, a, b uint8) {
+	p := goposit.NewPosit8()
+	x, y := p.SetBits(a), p.SetBits(b)
+	xv, yv := ref8.value(uint64(a)), ref8.value(uint64(b))
+	nar := xv == nil || yv == nil
+	result := func(f func(z, x, y *big.Rat) *big.Rat) uint64 {
+		if nar {
+			return ref8.nar()
+		}
+		return ref8.round(f(new(big.Rat), xv, yv), false)
+	}
+	in := []uint64{uint64(a), uint64(b)}
+	c.check("Add", ref8, uint64(x.Add(y).Bits()), result((*big.Rat).Add), in...)
+	c.check("Sub", ref8, uint64(x.Sub(y).Bits()), result((*big.Rat).Sub), in...)
+	c.check("Mul", ref8, uint64(x.Mul(y).Bits()), result((*big.Rat).Mul), in...)
+	if !nar && yv.Sign() == 0 {
+		c.check("Div", ref8, uint64(x.Div(y).Bits()), ref8.nar(), in...)
+	} else {
+		c.check("Div", ref8, uint64(x.Div(y).Bits()), result((*big.Rat).Quo), in...)
+	}
+
+	// MulPromote is exact, DivPromote rounds once into the bigger size
+	mp := uint64(x.MulPromote(y).Bits())
+	dp := uint64(x.DivPromote(y).Bits())
+	switch {
+	case nar || yv.Sign() == 0:
+		c.check("MulPromote", ref16, mp, boolNaR(ref16, nar), in...)
+		c.check("DivPromote", ref16, dp, ref16.nar(), in...)
+	default:
+		prod := new(big.Rat).Mul(xv, yv)
+		c.check("MulPromote", ref16, mp, ref16.round(prod, false), in...)
+		if mp != ref16.nar() && ref16.value(mp).Cmp(prod) != 0 {
+			c.t.Errorf("MulPromote %x %x is not exact", a, b)
+		}
+		c.check("DivPromote", ref16, dp, ref16.round(new(big.Rat).Quo(xv, yv), false), in...)
+	}
+
+	for _, sub := range []bool{false, true} {
+		var z, rem goposit.Posit8
+		op := "AddExact"
+		if sub {
+			op = "SubExact"
+			z, rem = x.SubExact(y)
+		} else {
+			z, rem = x.AddExact(y)
+		}
+		if nar {
+			c.check(op, ref8, uint64(z.Bits()), ref8.nar(), in...)
+			c.check(op+" remainder", ref8, uint64(rem.Bits()), ref8.nar(), in...)
+			continue
+		}
+		sum := new(big.Rat).Add(xv, yv)
+		if sub {
+			sum.Sub(xv, yv)
+		}
+		zb := ref8.floor(sum)
+		c.check(op, ref8, uint64(z.Bits()), zb, in...)
+		c.check(op+" remainder", ref8, uint64(rem.Bits()), ref8.round(sum.Sub(sum, ref8.value(zb)), false), in...)
+	}
+}
+
+func boolNaR(r ref, nar bool) uint64 {
+	if nar {
+		return r.nar()
+	}
+	return 0
+}
+
+func TestVerifyPosit8(t *testing.T) {
+	c := &checker{t: t}
+	for a := 0; a < 256; a++ {
+		for b := 0; b < 256; b++ {
+			checkBinary8(c, uint8(a), uint8(b))
+		}
+	}
+}
+
+func TestVerifyPosit16Unary(t *testing.T) {
+	c := &checker{t: t}
+	p := goposit.NewPosit16()
+	for i := 0; i < 1<<16; i++ {
+		x := p.SetBits(uint16(i))
+		v := ref16.value(uint64(i))
+		in := uint64(i)
+
+		sqrt := ref16.nar()
+		if v != nil && v.Sign() >= 0 {
+			sqrt = ref16.sqrt(v)
+		}
+		c.check("Sqrt", ref16, uint64(x.Sqrt().Bits()), sqrt, in)
+
+		up, down := ref32.nar(), ref8.nar()
+		if v != nil {
+			up, down = ref32.round(v, false), ref8.round(v, false)
+		}
+		c.check("Up", ref32, uint64(x.Up().Bits()), up, in)
+		c.check("Down", ref8, uint64(x.Down().Bits()), down, in)
+
+		// Int rounds to nearest even and saturates at +/-0x7fff, NaR gives 0x7fff
+		ival := int64(0x7fff)
+		if v != nil {
+			ival = roundInt(v).Int64()
+			if ival > 0x7fff {
+				ival = 0x7fff
+			} else if ival < -0x7fff {
+				ival = -0x7fff
+			}
+		}
+		if got := x.Int(); int64(got) != ival {
+			c.failed++
+			t.Errorf("Int %v: got %d expected %d", describe(16, 1, in), got, ival)
+		}
+
+		// Uint rounds to nearest even, negatives give 0 and it saturates at 0xffff as NaR does
+		uval := int64(0xffff)
+		if v != nil {
+			uval = roundInt(v).Int64()
+			if uval > 0xffff {
+				uval = 0xffff
+			} else if uval < 0 {
+				uval = 0
+			}
+		}
+		if got := x.Uint(); int64(got) != uval {
+			c.failed++
+			t.Errorf("Uint %v: got %d expected %d", describe(16, 1, in), got, uval)
+		}
+
+		c.check("FromInt", ref16, uint64(p.FromInt(int16(i)).Bits()), ref16.round(big.NewRat(int64(int16(i)), 1), false), in)
+		c.check("FromUint", ref16, uint64(p.FromUint(uint16(i)).Bits()), ref16.round(big.NewRat(int64(i), 1), false), in)
+		if c.failed > 20 {
+			return
+		}
+	}
+}
+
+func TestVerifyPosit16Sampled(t *testing.T) {
+	c := &checker{t: t}
+	p := goposit.NewPosit16()
+	seed := uint64(1)
+	next := func() uint16 {
+		seed = seed*6364136223846793005 + 1442695040888963407
+		return uint16(seed >> 48)
+	}
+	for i := 0; i < 50000; i++ {
+		a, b := next(), next()
+		x, y := p.SetBits(a), p.SetBits(b)
+		xv, yv := ref16.value(uint64(a)), ref16.value(uint64(b))
+		in := []uint64{uint64(a), uint64(b)}
+		if xv == nil || yv == nil {
+			continue
+		}
+		c.check("Add", ref16, uint64(x.Add(y).Bits()), ref16.round(new(big.Rat).Add(xv, yv), false), in...)
+		c.check("Sub", ref16, uint64(x.Sub(y).Bits()), ref16.round(new(big.Rat).Sub(xv, yv), false), in...)
+		c.check("Mul", ref16, uint64(x.Mul(y).Bits()), ref16.round(new(big.Rat).Mul(xv, yv), false), in...)
+		if yv.Sign() != 0 {
+			c.check("Div", ref16, uint64(x.Div(y).Bits()), ref16.round(new(big.Rat).Quo(xv, yv), false), in...)
+		}
+		sum := new(big.Rat).Add(xv, yv)
+		z, rem := x.AddExact(y)
+		zb := ref16.floor(sum)
+		c.check("AddExact", ref16, uint64(z.Bits()), zb, in...)
+		c.check("AddExact remainder", ref16, uint64(rem.Bits()), ref16.round(sum.Sub(sum, ref16.value(zb)), false), in...)
+		if c.failed > 20 {
+			return
+		}
+	}
+}